@@ -73,7 +73,9 @@ type App struct {
 	dekCacheClient     *redis.Client             // redis client for DEK cache; closed on shutdown
 	healthChecker      *health.Checker           // periodic dependency probe; nil only in degraded test setups
 	pendingOrgCleaner  *handlers.PendingOrgCleaner
-	jwtSessionJanitor  *secrets.JWTSessionJanitor // Epic 56: prunes expired jwt_sessions rows
+	jwtSessionJanitor  *secrets.JWTSessionJanitor     // Epic 56: prunes expired jwt_sessions rows
+	auditPruner        *database.AuditRetentionPruner // nil unless AuditRetention is configured
+	terminalHandler    *handlers.TerminalHandler      // Epic 14: idle reaper started in Run
 	invitationsHandler *handlers.InvitationsHandler
 	emailService       *emailsvc.Service
 	emailHandler       *handlers.EmailHandler
@@ -103,6 +105,23 @@ func newEmailMailer(cfg *config.Config) (emailpkg.EmailProvider, error) {
 	}
 }
 
+// newAuditPruner builds the audit_log retention pruner, or returns nil
+// when no retention bound is configured.
+func newAuditPruner(cfg *config.Config, store *database.PgOrgStore, log *logger.Logger) *database.AuditRetentionPruner {
+	policy := database.AuditRetentionPolicy{
+		MaxAge:   cfg.AuditRetention.MaxAge,
+		MaxCount: cfg.AuditRetention.MaxCount,
+	}
+	if !policy.Enabled() {
+		return nil
+	}
+	var archiver database.AuditArchiver
+	if cfg.AuditRetention.ArchiveDir != "" {
+		archiver = &database.FileAuditArchiver{Dir: cfg.AuditRetention.ArchiveDir}
+	}
+	return database.NewAuditRetentionPruner(store, archiver, policy, cfg.AuditRetention.PruneInterval, log)
+}
+
 //nolint:funlen,gocyclo // Sequential service initialization; decomposition would require a 20-field return struct with no clarity gain
 func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -172,6 +191,12 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		log.Warn("Redis cache service unavailable — ProxyHandler is using InMemoryStore. Multi-replica deployments will NOT share per-workspace state (active sessions, tombstones, password cache). This is expected for single-replica dev/test; investigate in production.")
 	}
 
+	// Usage samples live in the shared cache so every replica serves the
+	// same GET /workspaces/:id/metrics series.
+	if svc.Cache != nil {
+		proxyHandler.SetUsageHistory(workspace.NewUsageHistory(svc.Cache, workspace.DefaultUsageHistorySamples))
+	}
+
 	if svc.Metering != nil {
 		proxyHandler.SetMeteringService(svc.Metering)
 		if concrete, ok := svc.Metering.(*metering.Service); ok {
@@ -234,6 +259,7 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 	var orgCredBinder *secrets.PgSecretStore
 	var keyService *secrets.KeyService
 	var jwtSessionJanitor *secrets.JWTSessionJanitor // populated when secrets are enabled; goroutine started below
+	var auditPruner *database.AuditRetentionPruner   // populated when audit retention is configured; goroutine started in Run
 	var policySvc *policy.Service
 	var policyHandler *handlers.PolicyHandler
 	var promptSvc *prompt.Service
@@ -477,6 +503,7 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		}
 
 		pgOrgStore = database.NewPgOrgStore(dbSvc.DB)
+		auditPruner = newAuditPruner(cfg, pgOrgStore, log)
 		orgsHandler = handlers.NewOrgsHandler(pgOrgStore, svc.GetAuth())
 		orgCredsHandler = handlers.NewOrgCredentialsHandler(pgStore, pgStore, orgCredsProv, svc.GetAuth())
 
@@ -511,6 +538,9 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		// revocation primitive (MarkUserSuspended/ClearUserSuspended). log
 		// surfaces best-effort audit-write + revocation-write failures.
 		platformAdminHandler = handlers.NewPlatformAdminHandler(pgOrgStore, dbSvc, svc.GetAuth(), svc.GetAuth(), log)
+		if authSvc, ok := svc.Auth.(*auth.Service); ok {
+			platformAdminHandler.SetUserImporter(authSvc)
+		}
 		internalOrgStatusHandler = handlers.NewInternalOrgStatusHandler(pgOrgStore)
 
 		// US-54.1: login discovery handler for POST /api/v1/auth/lookup. Harmless
@@ -598,13 +628,10 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		rateLimitCfg.Strategy = cfg.RateLimiting.Strategy
 	}
 
-	wsOrigins := server.DefaultRouterConfig().AllowedWebSocketOrigins
-	if len(cfg.Security.AllowedOrigins) > 0 && cfg.Security.AllowedOrigins[0] != "*" {
-		wsOrigins = cfg.Security.AllowedOrigins
-	}
-
 	// Create terminal handler (Epic 14 — WebSocket terminal proxy).
 	terminalHandler := handlers.NewTerminalHandler(svc.Cache, &k8sWorkspaceGetterAdapter{client: k8sClient, namespace: cfg.Kubernetes.Namespace}, cfg.Kubernetes.Namespace, log)
+	terminalHandler.SetSessionLimits(cfg.Terminal.MaxSessions, cfg.Terminal.IdleTimeout, cfg.Terminal.EvictionPolicy)
+	terminalHandler.SetAllowedOrigins(cfg.Security.AllowedOrigins)
 
 	// Epic 27a: Agent reload handler.
 	var agentReloadHandler *handlers.AgentReloadHandler
@@ -683,6 +710,15 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		adminSessionHandler = handlers.NewAdminSessionHandler(proxyHandler, nil, log)
 	}
 
+	// Admin-only force-clean of workspaces stuck terminating. Same audit
+	// DB handling as the session recovery handler above.
+	var adminWorkspaceHandler *handlers.AdminWorkspaceHandler
+	if dbSvc, ok := svc.Database.(*database.Service); ok {
+		adminWorkspaceHandler = handlers.NewAdminWorkspaceHandler(svc.GetWorkspace(), dbSvc.DB, log)
+	} else {
+		adminWorkspaceHandler = handlers.NewAdminWorkspaceHandler(svc.GetWorkspace(), nil, log)
+	}
+
 	var checkoutProvider billing.CheckoutProvider
 	var webhookHandler *handlers.StripeWebhookHandler
 	if cfg.Billing.SecretKey != "" {
@@ -805,7 +841,6 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		RateLimitConfig:                 rateLimitCfg,
 		SecurityConfig:                  securityCfg,
 		TracingConfig:                   server.DefaultRouterConfig().TracingConfig,
-		AllowedWebSocketOrigins:         wsOrigins,
 		SettingsHandler:                 settingsHandler,
 		InstanceSettings:                instanceSettings,
 		AdminProviderCredentialsHandler: adminProvCredHandler,
@@ -832,6 +867,7 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		AuditHandler:                    auditHandler,
 		RelayAdminHandler:               relayAdminHandler,
 		AdminSessionHandler:             adminSessionHandler,
+		AdminWorkspaceHandler:           adminWorkspaceHandler,
 		PlatformAdminHandler:            platformAdminHandler,
 		InternalOrgStatusHandler:        internalOrgStatusHandler,
 		PodBootstrapHandler:             podBootstrapHandler,
@@ -868,6 +904,8 @@ func New(cfg *config.Config, log *logger.Logger) (*App, error) {
 		secretsPool:        secretsPool,
 		pendingOrgCleaner:  pendingOrgCleaner,
 		jwtSessionJanitor:  jwtSessionJanitor,
+		auditPruner:        auditPruner,
+		terminalHandler:    terminalHandler,
 		invitationsHandler: invitationsHandler,
 		emailService:       emailService,
 		emailHandler:       emailHandler,
@@ -918,6 +956,16 @@ func (a *App) Run() error {
 		a.logger.Info("jwt_sessions janitor started", "interval", secrets.DefaultJWTSessionJanitorInterval.String())
 	}
 
+	if a.auditPruner != nil {
+		go a.auditPruner.Run(a.ctx)
+		a.logger.Info("audit_log retention pruner started")
+	}
+
+	// Epic 14: close terminal sessions idle past Terminal.IdleTimeout.
+	if a.terminalHandler != nil {
+		go a.terminalHandler.RunIdleReaper(a.ctx)
+	}
+
 	// Start instance settings (loads cache from DB).
 	if err := a.instanceSettings.Start(); err != nil {
 		a.logger.Warn("Instance settings failed to start (will use defaults)", "error", err.Error())
@@ -1057,6 +1105,17 @@ func (a *App) Shutdown() error {
 		a.logger.Error("HTTP server shutdown error", err)
 	}
 
+	// Terminal WebSockets are hijacked, so server.Shutdown neither waited
+	// for nor closed them. Give them what is left of the shutdown budget
+	// to close cleanly, then cut them off.
+	if a.terminalHandler != nil {
+		drain := time.Duration(0)
+		if deadline, ok := ctx.Deadline(); ok {
+			drain = time.Until(deadline)
+		}
+		a.terminalHandler.CloseAllSessions(true, drain)
+	}
+
 	if err := a.proxyHandler.Stop(); err != nil {
 		a.logger.Error("Proxy handler shutdown error", err)
 	}
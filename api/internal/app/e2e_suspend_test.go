@@ -205,6 +205,9 @@ func (d *recordingDB) DeleteWorkspace(context.Context, string) error { return ni
 func (d *recordingDB) ListWorkspaces(context.Context, string, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
 	return nil, nil, nil
 }
+func (d *recordingDB) ListWorkspacesFiltered(context.Context, string, types.WorkspaceListFilter, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	return nil, nil, nil
+}
 func (d *recordingDB) CountWorkspacesByUserAndOrg(context.Context, string, string) (int, error) {
 	return 0, nil
 }
@@ -103,6 +103,42 @@ type Config struct {
 		RequestBufferTimeoutSeconds   int `mapstructure:"requestBufferTimeoutSeconds"`
 	} `mapstructure:"proxy"`
 
+	// Terminal holds WebSocket terminal session limits (Epic 14).
+	// MaxSessions caps concurrent sessions per API replica; EvictionPolicy
+	// picks what happens at the cap: "reject" (default) refuses the new
+	// session with 429, "lru" closes the least-recently-active session.
+	// IdleTimeout reaps sessions with no traffic in either direction.
+	// Zero values keep the handler defaults (500 sessions, 30m idle).
+	Terminal struct {
+		MaxSessions    int           `mapstructure:"maxSessions"`
+		IdleTimeout    time.Duration `mapstructure:"idleTimeout"`
+		EvictionPolicy string        `mapstructure:"evictionPolicy"`
+	} `mapstructure:"terminal"`
+
+	// Workspace tunes the workspace service. RuntimeCacheResync is the
+	// resync period of the informer behind RuntimeEnvironment lookups:
+	// zero keeps the default (10m), negative disables the cache so every
+	// create reads RuntimeEnvironments from the API server. CacheTTL is
+	// how long GET /workspaces/:id serves a resolved workspace from Redis:
+	// zero keeps the default (5s), negative disables it.
+	Workspace struct {
+		RuntimeCacheResync time.Duration `mapstructure:"runtimeCacheResync"`
+		CacheTTL           time.Duration `mapstructure:"cacheTTL"`
+	} `mapstructure:"workspace"`
+
+	// AuditRetention bounds the audit_log table. Rows older than MaxAge
+	// and rows beyond the newest MaxCount are pruned every PruneInterval.
+	// Zero MaxAge and MaxCount keep audit history forever (the default).
+	// When ArchiveDir is set, each batch is written there as JSON lines
+	// before deletion; point it at an object-storage mount for long-term
+	// archival.
+	AuditRetention struct {
+		MaxAge        time.Duration `mapstructure:"maxAge"`
+		MaxCount      int           `mapstructure:"maxCount"`
+		PruneInterval time.Duration `mapstructure:"pruneInterval"`
+		ArchiveDir    string        `mapstructure:"archiveDir"`
+	} `mapstructure:"auditRetention"`
+
 	// Billing holds Stripe configuration for org subscriptions (Epic 43).
 	// When SecretKey is empty, a NoopCheckoutProvider is used and the webhook
 	// endpoint rejects all deliveries — development/test mode.
@@ -289,6 +325,50 @@ func Load(path string) (*Config, error) {
 		}
 	}
 
+	if v := os.Getenv("LLMSAFESPACES_TERMINAL_MAXSESSIONS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			config.Terminal.MaxSessions = n
+		}
+	}
+	if v := os.Getenv("LLMSAFESPACES_TERMINAL_IDLETIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			config.Terminal.IdleTimeout = d
+		}
+	}
+	if v := os.Getenv("LLMSAFESPACES_TERMINAL_EVICTIONPOLICY"); v != "" {
+		config.Terminal.EvictionPolicy = v
+	}
+
+	if v := os.Getenv("LLMSAFESPACES_WORKSPACE_RUNTIMECACHERESYNC"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			config.Workspace.RuntimeCacheResync = d
+		}
+	}
+	if v := os.Getenv("LLMSAFESPACES_WORKSPACE_CACHETTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			config.Workspace.CacheTTL = d
+		}
+	}
+
+	if v := os.Getenv("LLMSAFESPACES_AUDITRETENTION_MAXAGE"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			config.AuditRetention.MaxAge = d
+		}
+	}
+	if v := os.Getenv("LLMSAFESPACES_AUDITRETENTION_MAXCOUNT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			config.AuditRetention.MaxCount = n
+		}
+	}
+	if v := os.Getenv("LLMSAFESPACES_AUDITRETENTION_PRUNEINTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			config.AuditRetention.PruneInterval = d
+		}
+	}
+	if v := os.Getenv("LLMSAFESPACES_AUDITRETENTION_ARCHIVEDIR"); v != "" {
+		config.AuditRetention.ArchiveDir = v
+	}
+
 	if v := os.Getenv("LLMSAFESPACES_BILLING_SECRETKEY"); v != "" {
 		config.Billing.SecretKey = v
 	}
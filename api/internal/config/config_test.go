@@ -278,3 +278,91 @@ func TestConfig_ProxyRequestBuffer_InvalidEnvIgnored(t *testing.T) {
 		t.Errorf("non-positive timeout env should be ignored; expected 0, got %d", cfg.Proxy.RequestBufferTimeoutSeconds)
 	}
 }
+
+func TestConfig_Terminal_EnvOverrides(t *testing.T) {
+	t.Setenv("LLMSAFESPACES_TERMINAL_MAXSESSIONS", "50")
+	t.Setenv("LLMSAFESPACES_TERMINAL_IDLETIMEOUT", "10m")
+	t.Setenv("LLMSAFESPACES_TERMINAL_EVICTIONPOLICY", "lru")
+	path := writeMinimalConfig(t, "")
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Terminal.MaxSessions != 50 {
+		t.Errorf("expected MaxSessions=50 from env, got %d", cfg.Terminal.MaxSessions)
+	}
+	if cfg.Terminal.IdleTimeout != 10*time.Minute {
+		t.Errorf("expected IdleTimeout=10m from env, got %v", cfg.Terminal.IdleTimeout)
+	}
+	if cfg.Terminal.EvictionPolicy != "lru" {
+		t.Errorf("expected EvictionPolicy=lru from env, got %q", cfg.Terminal.EvictionPolicy)
+	}
+}
+
+func TestConfig_Terminal_InvalidEnvIgnored(t *testing.T) {
+	t.Setenv("LLMSAFESPACES_TERMINAL_MAXSESSIONS", "-1")
+	t.Setenv("LLMSAFESPACES_TERMINAL_IDLETIMEOUT", "soon")
+	path := writeMinimalConfig(t, "")
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Terminal.MaxSessions != 0 {
+		t.Errorf("non-positive max sessions env should be ignored; got %d", cfg.Terminal.MaxSessions)
+	}
+	if cfg.Terminal.IdleTimeout != 0 {
+		t.Errorf("invalid idle timeout env should be ignored; got %v", cfg.Terminal.IdleTimeout)
+	}
+}
+
+func TestConfig_Workspace_RuntimeCacheResyncEnv(t *testing.T) {
+	for env, want := range map[string]time.Duration{"5m": 5 * time.Minute, "-1s": -time.Second, "often": 0} {
+		t.Setenv("LLMSAFESPACES_WORKSPACE_RUNTIMECACHERESYNC", env)
+		path := writeMinimalConfig(t, "")
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg.Workspace.RuntimeCacheResync != want {
+			t.Errorf("env %q: expected RuntimeCacheResync=%v, got %v", env, want, cfg.Workspace.RuntimeCacheResync)
+		}
+	}
+}
+
+func TestConfig_Workspace_CacheTTLEnv(t *testing.T) {
+	for env, want := range map[string]time.Duration{"2s": 2 * time.Second, "-1s": -time.Second, "brief": 0} {
+		t.Setenv("LLMSAFESPACES_WORKSPACE_CACHETTL", env)
+		path := writeMinimalConfig(t, "")
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg.Workspace.CacheTTL != want {
+			t.Errorf("env %q: expected CacheTTL=%v, got %v", env, want, cfg.Workspace.CacheTTL)
+		}
+	}
+}
+
+func TestConfig_AuditRetention_EnvOverrides(t *testing.T) {
+	t.Setenv("LLMSAFESPACES_AUDITRETENTION_MAXAGE", "2160h")
+	t.Setenv("LLMSAFESPACES_AUDITRETENTION_MAXCOUNT", "100000")
+	t.Setenv("LLMSAFESPACES_AUDITRETENTION_PRUNEINTERVAL", "30m")
+	t.Setenv("LLMSAFESPACES_AUDITRETENTION_ARCHIVEDIR", "/archive/audit")
+	path := writeMinimalConfig(t, "")
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.AuditRetention.MaxAge != 90*24*time.Hour {
+		t.Errorf("expected MaxAge=2160h from env, got %v", cfg.AuditRetention.MaxAge)
+	}
+	if cfg.AuditRetention.MaxCount != 100000 {
+		t.Errorf("expected MaxCount=100000 from env, got %d", cfg.AuditRetention.MaxCount)
+	}
+	if cfg.AuditRetention.PruneInterval != 30*time.Minute {
+		t.Errorf("expected PruneInterval=30m from env, got %v", cfg.AuditRetention.PruneInterval)
+	}
+	if cfg.AuditRetention.ArchiveDir != "/archive/audit" {
+		t.Errorf("expected ArchiveDir from env, got %q", cfg.AuditRetention.ArchiveDir)
+	}
+}
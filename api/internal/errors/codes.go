@@ -0,0 +1,170 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package errors
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Error codes returned in the `code` field of every API error response.
+// This is the closed set clients may switch on: adding a code is a
+// backwards-compatible API change, renaming or removing one is not.
+// Constructors in this package and the sentinels defined alongside
+// services use these constants rather than string literals.
+const (
+	CodeValidation           = "validation_error"
+	CodeUnauthorized         = "unauthorized"
+	CodeNotFound             = "not_found"
+	CodeForbidden            = "forbidden"
+	CodeConflict             = "conflict"
+	CodeRateLimited          = "rate_limited"
+	CodeInternal             = "internal_error"
+	CodeBadRequest           = "bad_request"
+	CodeNotImplemented       = "not_implemented"
+	CodeServiceUnavailable   = "service_unavailable"
+	CodeNoPendingAgentReload = "no_pending_agent_reload"
+	CodeTooManySubscribers   = "too_many_subscribers"
+	CodeUnsupportedRuntime   = "unsupported_runtime"
+	CodeNoAccountForEmail    = "no_account_for_email"
+	CodeDrainTimeout         = "drain_timeout"
+	CodePaymentRequired      = "payment_required"
+)
+
+// Codes carried by pkg/errors.StatusError sentinels. pkg/ cannot import
+// this package, so the sentinels keep their literals and
+// TestKnownCodes_CoverPkgSentinels keeps the two lists in sync.
+const (
+	CodeNoRunningPod            = "no_running_pod"
+	CodeAutoBindingProtected    = "auto_binding_protected"
+	CodeSecretNotFound          = "secret_not_found"
+	CodeDuplicateSecret         = "duplicate_secret"
+	CodeDEKUnavailable          = "dek_unavailable"
+	CodeInvalidSecretType       = "invalid_secret_type"
+	CodeInvalidMetadata         = "invalid_metadata"
+	CodeInvalidPassword         = "invalid_password"
+	CodeUserKeysMissing         = "user_keys_missing"
+	CodeInvalidLLMProvider      = "invalid_llm_provider"
+	CodeWorkspaceNotFound       = "workspace_not_found"
+	CodeCiphertextDecryptFailed = "ciphertext_decrypt_failed"
+)
+
+var knownCodes = map[string]bool{
+	CodeValidation:           true,
+	CodeUnauthorized:         true,
+	CodeNotFound:             true,
+	CodeForbidden:            true,
+	CodeConflict:             true,
+	CodeRateLimited:          true,
+	CodeInternal:             true,
+	CodeBadRequest:           true,
+	CodeNotImplemented:       true,
+	CodeServiceUnavailable:   true,
+	CodeNoPendingAgentReload: true,
+	CodeTooManySubscribers:   true,
+	CodeUnsupportedRuntime:   true,
+	CodeNoAccountForEmail:    true,
+	CodeDrainTimeout:         true,
+	CodePaymentRequired:      true,
+
+	CodeNoRunningPod:            true,
+	CodeAutoBindingProtected:    true,
+	CodeSecretNotFound:          true,
+	CodeDuplicateSecret:         true,
+	CodeDEKUnavailable:          true,
+	CodeInvalidSecretType:       true,
+	CodeInvalidMetadata:         true,
+	CodeInvalidPassword:         true,
+	CodeUserKeysMissing:         true,
+	CodeInvalidLLMProvider:      true,
+	CodeWorkspaceNotFound:       true,
+	CodeCiphertextDecryptFailed: true,
+}
+
+// IsKnownCode reports whether code is part of the documented taxonomy.
+func IsKnownCode(code string) bool {
+	return knownCodes[code]
+}
+
+// CodeForStatus maps an HTTP status to the generic code for that class of
+// failure. Used for errors that carry a status but no code of their own.
+func CodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusPaymentRequired:
+		return CodePaymentRequired
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusNotImplemented:
+		return CodeNotImplemented
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	}
+	if status >= 400 && status < 500 {
+		return CodeBadRequest
+	}
+	return CodeInternal
+}
+
+// ErrorBody is the JSON shape of every API error response. Error keeps the
+// legacy human-readable string for existing clients; Code is the stable,
+// machine-readable field new clients should switch on. Respond and its
+// variants are the only writers of this shape.
+type ErrorBody struct {
+	Error   string                 `json:"error"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// coder is satisfied by pkg/errors.StatusError, which cannot import this
+// package but still carries a machine-readable code.
+type coder interface {
+	StatusCode() int
+	ErrorCode() string
+}
+
+// Response converts err into an HTTP status and an ErrorBody. APIError
+// values keep their own code and expose only their Message, never the
+// wrapped cause; errors exposing StatusCode() fall back to CodeForStatus;
+// anything else is an internal_error.
+func Response(err error) (int, ErrorBody) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode(), ErrorBody{
+			Error:   apiErr.Message,
+			Code:    apiErr.Code,
+			Message: apiErr.Message,
+			Details: apiErr.Details,
+		}
+	}
+	var ce coder
+	if errors.As(err, &ce) {
+		code := ce.ErrorCode()
+		if code == "" {
+			code = CodeForStatus(ce.StatusCode())
+		}
+		return ce.StatusCode(), ErrorBody{Error: err.Error(), Code: code, Message: err.Error()}
+	}
+	var statusErr interface{ StatusCode() int }
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode(), ErrorBody{
+			Error:   err.Error(),
+			Code:    CodeForStatus(statusErr.StatusCode()),
+			Message: err.Error(),
+		}
+	}
+	return http.StatusInternalServerError, ErrorBody{Error: err.Error(), Code: CodeInternal, Message: err.Error()}
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lenaxia/llmsafespaces/pkg/agent/opencode"
+	pkgerrors "github.com/lenaxia/llmsafespaces/pkg/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/secrets"
+)
+
+func TestConstructors_UseKnownCodes(t *testing.T) {
+	cases := []struct {
+		name string
+		err  *APIError
+		code string
+	}{
+		{"validation", NewValidationError("m", nil, nil), CodeValidation},
+		{"auth", NewAuthenticationError("m", nil), CodeUnauthorized},
+		{"not_found", NewNotFoundError("workspace", "w", nil), CodeNotFound},
+		{"forbidden", NewForbiddenError("m", nil), CodeForbidden},
+		{"conflict", NewConflictError("workspace", "w", nil), CodeConflict},
+		{"rate_limit", NewRateLimitError("m", 1, 0, nil), CodeRateLimited},
+		{"internal", NewInternalError("workspace_creation_failed", nil), CodeInternal},
+		{"bad_request", NewBadRequestError("m", nil), CodeBadRequest},
+		{"not_implemented", NewNotImplementedError("", "m", nil), CodeNotImplemented},
+		{"no_agent_state_row", ErrNoAgentStateRow, CodeNoPendingAgentReload},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.code, tc.err.Code)
+			assert.True(t, IsKnownCode(tc.err.Code), "code %q not in taxonomy", tc.err.Code)
+		})
+	}
+}
+
+func TestKnownCodes_CoverPkgSentinels(t *testing.T) {
+	for _, se := range []*pkgerrors.StatusError{
+		opencode.ErrNoRunningPod,
+		secrets.ErrAutoBindingProtected,
+		secrets.ErrSecretNotFound,
+		secrets.ErrDuplicateSecret,
+		secrets.ErrDEKUnavailable,
+		secrets.ErrInvalidSecretType,
+		secrets.ErrInvalidMetadata,
+		secrets.ErrInvalidPassword,
+		secrets.ErrUserKeysMissing,
+		secrets.ErrInvalidLLMProvider,
+		secrets.ErrWorkspaceNotOwned,
+		secrets.ErrCiphertextDecryptFailed,
+	} {
+		assert.True(t, IsKnownCode(se.Code), "code %q not in taxonomy", se.Code)
+	}
+}
+
+func TestIsKnownCode_RejectsAdHocCodes(t *testing.T) {
+	assert.False(t, IsKnownCode("workspace_creation_failed"))
+	assert.False(t, IsKnownCode(""))
+}
+
+func TestCodeForStatus(t *testing.T) {
+	cases := map[int]string{
+		http.StatusBadRequest:          CodeBadRequest,
+		http.StatusUnauthorized:        CodeUnauthorized,
+		http.StatusPaymentRequired:     CodePaymentRequired,
+		http.StatusForbidden:           CodeForbidden,
+		http.StatusNotFound:            CodeNotFound,
+		http.StatusConflict:            CodeConflict,
+		http.StatusPreconditionFailed:  CodeConflict,
+		http.StatusUnprocessableEntity: CodeValidation,
+		http.StatusTooManyRequests:     CodeRateLimited,
+		http.StatusNotImplemented:      CodeNotImplemented,
+		http.StatusServiceUnavailable:  CodeServiceUnavailable,
+		http.StatusTeapot:              CodeBadRequest,
+		http.StatusBadGateway:          CodeInternal,
+	}
+	for status, code := range cases {
+		assert.Equal(t, code, CodeForStatus(status), "status %d", status)
+		assert.True(t, IsKnownCode(CodeForStatus(status)))
+	}
+}
+
+func TestResponse_APIErrorKeepsCodeAndDetails(t *testing.T) {
+	err := fmt.Errorf("create: %w", NewValidationError("invalid name", map[string]interface{}{"field": "name"}, nil))
+
+	status, body := Response(err)
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.Equal(t, CodeValidation, body.Code)
+	assert.Equal(t, "invalid name", body.Message)
+	assert.Equal(t, "name", body.Details["field"])
+	assert.Equal(t, "invalid name", body.Error)
+}
+
+func TestResponse_APIErrorHidesWrappedCause(t *testing.T) {
+	_, body := Response(NewInternalError("workspace_get_failed", errors.New("etcd: connection refused")))
+	assert.NotContains(t, body.Error, "etcd")
+	assert.NotContains(t, body.Message, "etcd")
+}
+
+func TestResponse_StatusErrorUsesItsCode(t *testing.T) {
+	se := pkgerrors.NewStatusError(http.StatusNotFound, "secret_not_found", "secret not found")
+
+	status, body := Response(fmt.Errorf("lookup: %w", se))
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "secret_not_found", body.Code)
+}
+
+func TestResponse_StatusErrorWithoutCodeMapsByStatus(t *testing.T) {
+	status, body := Response(pkgerrors.NewStatusError(http.StatusPreconditionFailed, "", "stale"))
+	assert.Equal(t, http.StatusPreconditionFailed, status)
+	assert.Equal(t, CodeConflict, body.Code)
+}
+
+func TestResponse_PlainErrorIsInternal(t *testing.T) {
+	status, body := Response(errors.New("boom"))
+	assert.Equal(t, http.StatusInternalServerError, status)
+	assert.Equal(t, CodeInternal, body.Code)
+}
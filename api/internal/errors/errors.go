@@ -14,12 +14,12 @@ import (
 // import this shared package — neither imports the other.
 //
 // It is a *APIError (not a plain sentinel) so the centralized error handler
-// (respondWithError) can map it to HTTP 409 Conflict automatically via
+// (Respond) can map it to HTTP 409 Conflict automatically via
 // StatusCode(). Callers can still use errors.Is for backwards compat and
 // errors.As for the new typed-error path.
 var ErrNoAgentStateRow = &APIError{
 	Type:    ErrorTypeConflict,
-	Code:    "no_pending_agent_reload",
+	Code:    CodeNoPendingAgentReload,
 	Message: "workspace has no pending credentials to reload",
 }
 
@@ -50,6 +50,9 @@ const (
 
 	// ErrorTypeBadRequest represents bad request errors
 	ErrorTypeBadRequest ErrorType = "bad_request"
+
+	// ErrorTypeTimeout represents a wait that gave up before its condition held
+	ErrorTypeTimeout ErrorType = "timeout"
 )
 
 // APIError represents an API error
@@ -91,6 +94,8 @@ func (e *APIError) StatusCode() int {
 		return http.StatusTooManyRequests
 	case ErrorTypeBadRequest:
 		return http.StatusBadRequest
+	case ErrorTypeTimeout:
+		return http.StatusRequestTimeout
 	default:
 		return http.StatusInternalServerError
 	}
@@ -100,7 +105,7 @@ func (e *APIError) StatusCode() int {
 func NewValidationError(message string, details map[string]interface{}, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeValidation,
-		Code:    "validation_error",
+		Code:    CodeValidation,
 		Message: message,
 		Details: details,
 		Err:     err,
@@ -111,7 +116,7 @@ func NewValidationError(message string, details map[string]interface{}, err erro
 func NewAuthenticationError(message string, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeAuth,
-		Code:    "unauthorized",
+		Code:    CodeUnauthorized,
 		Message: message,
 		Err:     err,
 	}
@@ -121,7 +126,7 @@ func NewAuthenticationError(message string, err error) *APIError {
 func NewNotFoundError(resourceType, resourceID string, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeNotFound,
-		Code:    "not_found",
+		Code:    CodeNotFound,
 		Message: fmt.Sprintf("%s %s not found", resourceType, resourceID),
 		Details: map[string]interface{}{
 			"resourceType": resourceType,
@@ -135,7 +140,7 @@ func NewNotFoundError(resourceType, resourceID string, err error) *APIError {
 func NewForbiddenError(message string, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeForbidden,
-		Code:    "forbidden",
+		Code:    CodeForbidden,
 		Message: message,
 		Err:     err,
 	}
@@ -145,7 +150,7 @@ func NewForbiddenError(message string, err error) *APIError {
 func NewConflictError(resourceType, resourceID string, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeConflict,
-		Code:    "conflict",
+		Code:    CodeConflict,
 		Message: fmt.Sprintf("%s %s already exists", resourceType, resourceID),
 		Details: map[string]interface{}{
 			"resourceType": resourceType,
@@ -159,7 +164,7 @@ func NewConflictError(resourceType, resourceID string, err error) *APIError {
 func NewRateLimitError(message string, limit int, reset int64, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeRateLimit,
-		Code:    "rate_limited",
+		Code:    CodeRateLimited,
 		Message: message,
 		Details: map[string]interface{}{
 			"limit": limit,
@@ -173,7 +178,7 @@ func NewRateLimitError(message string, limit int, reset int64, err error) *APIEr
 func NewInternalError(message string, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeInternal,
-		Code:    "internal_error",
+		Code:    CodeInternal,
 		Message: message,
 		Err:     err,
 	}
@@ -183,14 +188,19 @@ func NewInternalError(message string, err error) *APIError {
 func NewBadRequestError(message string, err error) *APIError {
 	return &APIError{
 		Type:    ErrorTypeBadRequest,
-		Code:    "bad_request",
+		Code:    CodeBadRequest,
 		Message: message,
 		Err:     err,
 	}
 }
 
-// NewNotImplementedError creates a new not implemented error
+// NewNotImplementedError creates a new not implemented error. An empty
+// code defaults to CodeNotImplemented; any other value should be one of
+// the Code constants.
 func NewNotImplementedError(code string, message string, err error) *APIError {
+	if code == "" {
+		code = CodeNotImplemented
+	}
 	return &APIError{
 		Type:    ErrorTypeInternal,
 		Code:    code,
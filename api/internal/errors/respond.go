@@ -0,0 +1,31 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package errors
+
+import "github.com/gin-gonic/gin"
+
+// Respond writes err as an ErrorBody and aborts the handler chain. It is
+// the single exit for handler and middleware failures, so every error
+// response carries the same {error, code, message, details} shape.
+func Respond(c *gin.Context, err error) {
+	status, body := Response(err)
+	c.AbortWithStatusJSON(status, body)
+}
+
+// RespondStatus writes a failure detected in the handler itself, where
+// there is no service error to convert. The code is derived from status.
+func RespondStatus(c *gin.Context, status int, message string) {
+	RespondDetails(c, status, message, nil)
+}
+
+// RespondDetails is RespondStatus with structured details, e.g. the
+// offending field of a validation failure or a retryAfter hint.
+func RespondDetails(c *gin.Context, status int, message string, details map[string]interface{}) {
+	c.AbortWithStatusJSON(status, ErrorBody{
+		Error:   message,
+		Code:    CodeForStatus(status),
+		Message: message,
+		Details: details,
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lenaxia/llmsafespaces/pkg/secrets"
+)
+
+// TestRespond_StableCodes pins the `code` each failure path reports.
+// Clients switch on these values, so a change here is an API break.
+func TestRespond_StableCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cases := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"validation", NewValidationError("bad name", map[string]interface{}{"field": "name"}, nil), http.StatusUnprocessableEntity, CodeValidation},
+		{"not found", NewNotFoundError("workspace", "ws-1", nil), http.StatusNotFound, CodeNotFound},
+		{"forbidden", NewForbiddenError("nope", nil), http.StatusForbidden, CodeForbidden},
+		{"internal", NewInternalError("workspace_get_failed", errors.New("etcd down")), http.StatusInternalServerError, CodeInternal},
+		{"agent reload sentinel", ErrNoAgentStateRow, http.StatusConflict, CodeNoPendingAgentReload},
+		{"pkg status error", fmt.Errorf("lookup: %w", secrets.ErrSecretNotFound), http.StatusNotFound, CodeSecretNotFound},
+		{"plain error", errors.New("boom"), http.StatusInternalServerError, CodeInternal},
+		{"drain timeout", &APIError{Type: ErrorTypeTimeout, Code: CodeDrainTimeout, Message: "busy"}, http.StatusRequestTimeout, CodeDrainTimeout},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			Respond(c, tc.err)
+
+			assert.Equal(t, tc.status, w.Code)
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tc.code, body["code"])
+			assert.NotEmpty(t, body["message"])
+			assert.NotEmpty(t, body["error"], "legacy error string kept for existing clients")
+		})
+	}
+}
+
+func TestRespondStatus_DerivesCodeFromStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	RespondStatus(c, http.StatusNotFound, "org not found")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.True(t, c.IsAborted())
+	assert.JSONEq(t, `{"error":"org not found","code":"not_found","message":"org not found"}`, w.Body.String())
+}
+
+func TestRespondDetails_CarriesDetails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	RespondDetails(c, http.StatusServiceUnavailable, "workspace restarting", map[string]interface{}{"retryAfter": 5})
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var body ErrorBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, CodeServiceUnavailable, body.Code)
+	assert.Equal(t, float64(5), body.Details["retryAfter"])
+}
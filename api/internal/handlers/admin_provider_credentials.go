@@ -13,6 +13,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
 )
 
@@ -136,16 +137,16 @@ func NewAdminProviderCredentialsHandler(store CredentialStore, provider secrets.
 func (h *AdminProviderCredentialsHandler) Create(c *gin.Context) {
 	var req createAdminCredentialRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if strings.TrimSpace(req.Kind) == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must not be empty"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "kind must not be empty")
 		return
 	}
 	if strings.TrimSpace(req.Slug) == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "slug must not be empty"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "slug must not be empty")
 		return
 	}
 	req.Kind = strings.TrimSpace(req.Kind)
@@ -159,22 +160,26 @@ func (h *AdminProviderCredentialsHandler) Create(c *gin.Context) {
 	// validators live in pkg/secrets so the regex and enum are shared
 	// with the DB CHECK declarations via property tests.
 	if err := secrets.ValidateKind(req.Kind); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "kind"})
+		apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+			"field": "kind",
+		})
 		return
 	}
 	if err := secrets.ValidateSlug(req.Slug); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "slug"})
+		apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+			"field": "slug",
+		})
 		return
 	}
 
 	if h.provider == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "master secret not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "master secret not configured")
 		return
 	}
 
 	ciphertext, err := encryptCredentialData(c.Request.Context(), h.provider.Encrypt, req.Kind, req.Slug, req.APIKey, req.BaseURL)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to encode credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to encode credential")
 		return
 	}
 
@@ -205,16 +210,16 @@ func (h *AdminProviderCredentialsHandler) Create(c *gin.Context) {
 	if err := h.store.CreateCredential(c.Request.Context(), "admin", "_platform", row); err != nil {
 		classified := ClassifyPostgresError(err)
 		if errors.Is(classified, ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "credential with this slug already exists"})
+			apierrors.RespondStatus(c, http.StatusConflict, "credential with this slug already exists")
 			return
 		}
 		if errors.Is(classified, ErrCredentialCheckViolation) {
 			// Defense in depth: boundary validation should have caught
 			// this. If it didn't, the Go/SQL regex pair has drifted.
-			c.JSON(http.StatusBadRequest, gin.H{"error": "credential failed validation; kind or slug is invalid"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "credential failed validation; kind or slug is invalid")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to store credential")
 		return
 	}
 
@@ -236,7 +241,7 @@ func (h *AdminProviderCredentialsHandler) Create(c *gin.Context) {
 func (h *AdminProviderCredentialsHandler) List(c *gin.Context) {
 	rows, err := h.store.ListCredentials(c.Request.Context(), "admin", "_platform")
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list credentials"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list credentials")
 		return
 	}
 
@@ -252,11 +257,11 @@ func (h *AdminProviderCredentialsHandler) Get(c *gin.Context) {
 	id := c.Param("id")
 	row, err := h.store.GetCredential(c.Request.Context(), "admin", "_platform", id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get credential")
 		return
 	}
 	if row == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 	c.JSON(http.StatusOK, buildCredentialResponse(c.Request.Context(), row, h.provider))
@@ -267,30 +272,34 @@ func (h *AdminProviderCredentialsHandler) Update(c *gin.Context) {
 	id := c.Param("id")
 	existing, err := h.store.GetCredential(c.Request.Context(), "admin", "_platform", id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get credential")
 		return
 	}
 	if existing == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 
 	var req updateAdminCredentialRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	// Validate kind/slug if the caller is updating them.
 	if req.Kind != nil {
 		if err := secrets.ValidateKind(*req.Kind); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "kind"})
+			apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+				"field": "kind",
+			})
 			return
 		}
 	}
 	if req.Slug != nil {
 		if err := secrets.ValidateSlug(*req.Slug); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "slug"})
+			apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+				"field": "slug",
+			})
 			return
 		}
 	}
@@ -325,7 +334,7 @@ func (h *AdminProviderCredentialsHandler) Update(c *gin.Context) {
 	// the matching guard in org_credentials.go).
 	if req.APIKey != nil || req.BaseURL != nil || req.Kind != nil || req.Slug != nil {
 		if h.provider == nil {
-			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "master secret not configured"})
+			apierrors.RespondStatus(c, http.StatusServiceUnavailable, "master secret not configured")
 			return
 		}
 		// Decrypt the existing ciphertext to get current values (C-4 fix).
@@ -333,17 +342,13 @@ func (h *AdminProviderCredentialsHandler) Update(c *gin.Context) {
 		// which would silently corrupt the stored credential.
 		existingPlain, decErr := h.provider.Decrypt(c.Request.Context(), existing.Ciphertext)
 		if decErr != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "existing credential data is unreadable; manual remediation required before key rotation",
-			})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "existing credential data is unreadable; manual remediation required before key rotation")
 			return
 		}
 		defer zeroBytes(existingPlain) // zero on all exit paths (success and failure)
 		var existingData secrets.LLMProviderData
 		if err := json.Unmarshal(existingPlain, &existingData); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "existing credential data is corrupt; cannot apply partial update",
-			})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "existing credential data is corrupt; cannot apply partial update")
 			return
 		}
 		// Apply only the fields being changed.
@@ -361,13 +366,13 @@ func (h *AdminProviderCredentialsHandler) Update(c *gin.Context) {
 		}
 		plaintext, marshalErr := json.Marshal(existingData) //nolint:gosec // marshaling for encryption
 		if marshalErr != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to encode credential"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to encode credential")
 			return
 		}
 		ciphertext, encErr := h.provider.Encrypt(c.Request.Context(), plaintext)
 		zeroBytes(plaintext)
 		if encErr != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "encryption failed"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "encryption failed")
 			return
 		}
 		existing.Ciphertext = ciphertext
@@ -377,14 +382,14 @@ func (h *AdminProviderCredentialsHandler) Update(c *gin.Context) {
 	if err := h.store.UpdateCredential(c.Request.Context(), "admin", "_platform", existing.ID, existing); err != nil {
 		classified := ClassifyPostgresError(err)
 		if errors.Is(classified, ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "a credential with this slug already exists"})
+			apierrors.RespondStatus(c, http.StatusConflict, "a credential with this slug already exists")
 			return
 		}
 		if errors.Is(classified, ErrCredentialCheckViolation) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "credential failed validation; kind or slug is invalid"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "credential failed validation; kind or slug is invalid")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update credential")
 		return
 	}
 
@@ -398,10 +403,10 @@ func (h *AdminProviderCredentialsHandler) Delete(c *gin.Context) {
 	id := c.Param("id")
 	if err := h.store.DeleteCredential(c.Request.Context(), "admin", "_platform", id); err != nil {
 		if errors.Is(ClassifyPostgresError(err), ErrCredentialNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete credential")
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -439,29 +444,29 @@ func (h *AdminProviderCredentialsHandler) CreateAutoApply(c *gin.Context) {
 	credID := c.Param("id")
 	var req createAutoApplyRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 	if req.TargetType != "all" && req.TargetType != "user" && req.TargetType != "org" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "targetType must be 'all', 'user', or 'org'"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "targetType must be 'all', 'user', or 'org'")
 		return
 	}
 	if h.autoApplyStore == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "auto-apply not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "auto-apply not configured")
 		return
 	}
 
 	var targetID *string
 	if req.TargetType != "all" {
 		if req.TargetID == "" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "targetId required when targetType is not 'all'"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "targetId required when targetType is not 'all'")
 			return
 		}
 		targetID = &req.TargetID
 	}
 
 	if err := h.autoApplyStore.CreateAutoApply(c.Request.Context(), credID, req.TargetType, targetID, req.Priority); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create auto-apply rule"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create auto-apply rule")
 		return
 	}
 	c.JSON(http.StatusCreated, autoApplyResponse{
@@ -476,12 +481,12 @@ func (h *AdminProviderCredentialsHandler) CreateAutoApply(c *gin.Context) {
 func (h *AdminProviderCredentialsHandler) ListAutoApply(c *gin.Context) {
 	credID := c.Param("id")
 	if h.autoApplyStore == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "auto-apply not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "auto-apply not configured")
 		return
 	}
 	rules, err := h.autoApplyStore.ListAutoApply(c.Request.Context(), credID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list auto-apply rules"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list auto-apply rules")
 		return
 	}
 	resp := make([]autoApplyResponse, 0, len(rules))
@@ -501,7 +506,7 @@ func (h *AdminProviderCredentialsHandler) DeleteAutoApply(c *gin.Context) {
 	targetType := c.Param("targetType")
 	targetIDParam := c.Param("targetId")
 	if h.autoApplyStore == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "auto-apply not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "auto-apply not configured")
 		return
 	}
 	var targetID *string
@@ -509,7 +514,7 @@ func (h *AdminProviderCredentialsHandler) DeleteAutoApply(c *gin.Context) {
 		targetID = &targetIDParam
 	}
 	if err := h.autoApplyStore.DeleteAutoApply(c.Request.Context(), credID, targetType, targetID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete auto-apply rule"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete auto-apply rule")
 		return
 	}
 	c.Status(http.StatusNoContent)
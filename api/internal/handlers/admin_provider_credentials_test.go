@@ -664,7 +664,7 @@ func TestAdminProviderCredentials_Create_InvalidKind_400(t *testing.T) {
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
 	assert.Contains(t, resp, "error", "response must carry an error message")
-	assert.Equal(t, "kind", resp["field"], "response must identify the offending field")
+	assert.Equal(t, "kind", resp["details"].(map[string]any)["field"], "response must identify the offending field")
 }
 
 // TestAdminProviderCredentials_Create_InvalidSlug_400 asserts the same
@@ -688,7 +688,7 @@ func TestAdminProviderCredentials_Create_InvalidSlug_400(t *testing.T) {
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
 	assert.Contains(t, resp, "error")
-	assert.Equal(t, "slug", resp["field"])
+	assert.Equal(t, "slug", resp["details"].(map[string]any)["field"])
 }
 
 // TestAdminProviderCredentials_Update_InvalidKind_400 asserts the validation
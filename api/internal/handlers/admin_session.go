@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	apitypes "github.com/lenaxia/llmsafespaces/api/internal/types"
 	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
 )
@@ -47,17 +48,16 @@ func (h *AdminSessionHandler) ForceAbortSession(c *gin.Context) {
 	sessionID := c.Param("sessionId")
 
 	if err := validateSessionID(sessionID); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	if workspaceID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "workspaceId required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "workspaceId required")
 		return
 	}
 
 	if !h.proxyHandler.isSessionActive(c.Request.Context(), workspaceID, sessionID) {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error":       "session is not currently active (nothing to abort)",
+		apierrors.RespondDetails(c, http.StatusNotFound, "session is not currently active (nothing to abort)", map[string]interface{}{
 			"sessionId":   sessionID,
 			"workspaceId": workspaceID,
 		})
@@ -98,8 +98,8 @@ func TestAdminSession_ForceAbort_NotActive_Returns404(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 	var body map[string]interface{}
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
-	assert.Equal(t, "sess-not-stuck", body["sessionId"])
-	assert.Equal(t, "ws-1", body["workspaceId"])
+	assert.Equal(t, "sess-not-stuck", body["details"].(map[string]interface{})["sessionId"])
+	assert.Equal(t, "ws-1", body["details"].(map[string]interface{})["workspaceId"])
 }
 
 func TestAdminSession_ForceAbort_InvalidSessionID_Returns400(t *testing.T) {
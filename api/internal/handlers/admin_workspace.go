@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// workspaceForceCleaner is the workspace service surface the handler needs.
+type workspaceForceCleaner interface {
+	ForceCleanWorkspace(ctx context.Context, workspaceID string) (*types.ForceCleanWorkspaceResult, error)
+}
+
+// AdminWorkspaceHandler serves admin-only workspace recovery endpoints.
+//
+// ForceClean unblocks a workspace stuck terminating (a finalizer that never
+// clears, a pod that never dies) so its name and resources are released.
+// Like AdminSessionHandler.ForceAbortSession it writes an audit-log row, and
+// audit failure is non-fatal: incident recovery must not be blocked by a DB
+// hiccup.
+type AdminWorkspaceHandler struct {
+	workspaces workspaceForceCleaner
+	db         *sql.DB
+	logger     pkginterfaces.LoggerInterface
+}
+
+func NewAdminWorkspaceHandler(workspaces workspaceForceCleaner, db *sql.DB, logger pkginterfaces.LoggerInterface) *AdminWorkspaceHandler {
+	return &AdminWorkspaceHandler{workspaces: workspaces, db: db, logger: logger}
+}
+
+// ForceClean handles POST /api/v1/admin/workspaces/:workspaceId/force-clean.
+// 409 when the workspace is not terminating or has not been terminating
+// for v1.StuckTerminatingThreshold yet.
+func (h *AdminWorkspaceHandler) ForceClean(c *gin.Context) {
+	workspaceID := c.Param("workspaceId")
+	if workspaceID == "" {
+		apierrors.RespondStatus(c, http.StatusBadRequest, "workspaceId required")
+		return
+	}
+
+	result, err := h.workspaces.ForceCleanWorkspace(c.Request.Context(), workspaceID)
+	if err != nil {
+		var apiErr *apierrors.APIError
+		if !errors.As(err, &apiErr) {
+			err = apierrors.NewInternalError("failed to force-clean workspace", err)
+		}
+		apierrors.Respond(c, err)
+		return
+	}
+
+	actorID, _ := extractAuth(c)
+	h.logAudit(c.Request.Context(), actorID, result)
+
+	h.logger.Info("admin force-cleaned stuck workspace",
+		"workspaceID", workspaceID, "actor", actorID,
+		"terminatingSeconds", result.TerminatingSeconds)
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *AdminWorkspaceHandler) logAudit(ctx context.Context, actorID string, result *types.ForceCleanWorkspaceResult) {
+	if h.db == nil {
+		return
+	}
+	metadata, err := json.Marshal(map[string]any{
+		"terminatingSeconds": result.TerminatingSeconds,
+		"podDeleted":         result.PodDeleted,
+		"removedFinalizers":  result.RemovedFinalizers,
+		"source":             "admin_force_clean",
+	})
+	if err != nil {
+		h.logger.Error("failed to marshal audit metadata", err, "workspaceID", result.WorkspaceID)
+		return
+	}
+	if _, err := h.db.ExecContext(ctx,
+		`INSERT INTO audit_log (actor_id, domain, action, target_id, metadata)
+		 VALUES ($1, 'admin', 'workspace_force_clean', $2, $3)`,
+		actorID, result.WorkspaceID, string(metadata)); err != nil {
+		h.logger.Error("failed to write audit log for workspace force-clean", err,
+			"workspaceID", result.WorkspaceID)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/api/internal/middleware"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+type fakeForceCleaner struct {
+	result *types.ForceCleanWorkspaceResult
+	err    error
+	called string
+}
+
+func (f *fakeForceCleaner) ForceCleanWorkspace(_ context.Context, workspaceID string) (*types.ForceCleanWorkspaceResult, error) {
+	f.called = workspaceID
+	return f.result, f.err
+}
+
+func setupAdminWorkspaceRouter(t *testing.T, h *AdminWorkspaceHandler, role string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", "admin-1")
+		c.Set("userRole", role)
+		c.Next()
+	})
+	g := r.Group("/api/v1/admin/workspaces/:workspaceId")
+	g.Use(middleware.AdminGuard())
+	g.POST("/force-clean", h.ForceClean)
+	return r
+}
+
+func doAdminForceClean(r *gin.Engine, workspaceID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/workspaces/"+workspaceID+"/force-clean", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAdminWorkspace_ForceClean_Success(t *testing.T) {
+	cleaner := &fakeForceCleaner{result: &types.ForceCleanWorkspaceResult{
+		WorkspaceID:        "ws-1",
+		TerminatingSeconds: 900,
+		PodDeleted:         true,
+		RemovedFinalizers:  []string{"workspace.llmsafespaces.dev/finalizer"},
+	}}
+	r := setupAdminWorkspaceRouter(t, NewAdminWorkspaceHandler(cleaner, nil, &testLogger{}), "admin")
+
+	w := doAdminForceClean(r, "ws-1")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ws-1", cleaner.called)
+	var got types.ForceCleanWorkspaceResult
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.True(t, got.PodDeleted)
+	assert.Equal(t, int64(900), got.TerminatingSeconds)
+}
+
+func TestAdminWorkspace_ForceClean_NotStuckIs409(t *testing.T) {
+	cleaner := &fakeForceCleaner{err: &apierrors.APIError{
+		Type: apierrors.ErrorTypeConflict, Code: apierrors.CodeConflict, Message: "workspace is not terminating",
+	}}
+	r := setupAdminWorkspaceRouter(t, NewAdminWorkspaceHandler(cleaner, nil, &testLogger{}), "admin")
+
+	w := doAdminForceClean(r, "ws-1")
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	var body apierrors.ErrorBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, apierrors.CodeConflict, body.Code)
+	assert.Equal(t, "workspace is not terminating", body.Message)
+}
+
+func TestAdminWorkspace_ForceClean_UnexpectedErrorIs500(t *testing.T) {
+	cleaner := &fakeForceCleaner{err: errors.New("etcd unavailable")}
+	r := setupAdminWorkspaceRouter(t, NewAdminWorkspaceHandler(cleaner, nil, &testLogger{}), "admin")
+
+	w := doAdminForceClean(r, "ws-1")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var body apierrors.ErrorBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, apierrors.CodeInternal, body.Code)
+	assert.NotContains(t, body.Message, "etcd")
+}
+
+func TestAdminWorkspace_ForceClean_NonAdminIs404(t *testing.T) {
+	cleaner := &fakeForceCleaner{}
+	r := setupAdminWorkspaceRouter(t, NewAdminWorkspaceHandler(cleaner, nil, &testLogger{}), "user")
+
+	w := doAdminForceClean(r, "ws-1")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Empty(t, cleaner.called, "non-admins must never reach the service")
+}
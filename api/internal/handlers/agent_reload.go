@@ -39,25 +39,6 @@ type BrokerPublisher interface {
 	PublishToWorkspace(workspaceID string, event apitypes.WorkspaceSSEEvent)
 }
 
-// respondWithAPIError maps API errors to HTTP responses. Uses errors.As to
-// find an *apierrors.APIError anywhere in the chain (handles wrapped errors),
-// then falls back to a duck-typed StatusCode() check, then 500.
-func respondWithAPIError(c *gin.Context, err error) {
-	var apiErr *apierrors.APIError
-	if errors.As(err, &apiErr) {
-		c.JSON(apiErr.StatusCode(), gin.H{"error": apiErr.Error()})
-		return
-	}
-	if ae, ok := err.(interface {
-		StatusCode() int
-		Error() string
-	}); ok {
-		c.JSON(ae.StatusCode(), gin.H{"error": ae.Error()})
-		return
-	}
-	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-}
-
 // AgentStateStore is the DB surface needed by the reload handler.
 type AgentStateStore interface {
 	GetLastCredentialChangedAt(ctx context.Context, workspaceID string) (time.Time, error)
@@ -182,28 +163,25 @@ func (h *AgentReloadHandler) Reload(c *gin.Context) {
 
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	ws, err := h.workspaceSvc.GetWorkspace(c.Request.Context(), userID, workspaceID)
+	// The phase gates the reload, so read it live rather than cached.
+	ws, err := h.workspaceSvc.GetWorkspace(types.WithFreshWorkspaceRead(c.Request.Context()), userID, workspaceID)
 	if err != nil {
-		respondWithAPIError(c, err)
+		apierrors.Respond(c, err)
 		return
 	}
 
 	if ws.Phase != "Active" {
-		c.JSON(http.StatusConflict, gin.H{
-			"error": fmt.Sprintf("cannot reload agent: workspace is in phase %q (must be Active)", ws.Phase),
-		})
+		apierrors.RespondStatus(c, http.StatusConflict, fmt.Sprintf("cannot reload agent: workspace is in phase %q (must be Active)", ws.Phase))
 		return
 	}
 
 	podIP, err := h.podResolver.GetWorkspacePodIP(c.Request.Context(), userID, workspaceID)
 	if err != nil || podIP == "" {
-		c.JSON(http.StatusConflict, gin.H{
-			"error": "cannot reload agent: workspace pod is not reachable",
-		})
+		apierrors.RespondStatus(c, http.StatusConflict, "cannot reload agent: workspace pod is not reachable")
 		return
 	}
 
@@ -220,7 +198,7 @@ func (h *AgentReloadHandler) Reload(c *gin.Context) {
 	if drain && h.sseTracker != nil && h.getPassword != nil {
 		pw, err := h.getPassword.WorkspacePassword(c.Request.Context(), workspaceID)
 		if err != nil {
-			respondWithAPIError(c, apierrors.NewInternalError("get_opencode_password_failed", err))
+			apierrors.Respond(c, apierrors.NewInternalError("get_opencode_password_failed", err))
 			return
 		}
 		opencodeCl := opencode.NewClient(
@@ -234,23 +212,22 @@ func (h *AgentReloadHandler) Reload(c *gin.Context) {
 				if h.metricsService != nil {
 					h.metricsService.RecordAgentReloadDrainTimeout(time.Since(start).Milliseconds())
 				}
-				c.JSON(http.StatusRequestTimeout, gin.H{
-					"error": gin.H{
-						"code":           "drain_timeout",
-						"message":        fmt.Sprintf("workspace did not become idle within %s", drainTimeout),
-						"busySessionIDs": drainErr.BusySessions,
-					},
+				apierrors.Respond(c, &apierrors.APIError{
+					Type:    apierrors.ErrorTypeTimeout,
+					Code:    apierrors.CodeDrainTimeout,
+					Message: fmt.Sprintf("workspace did not become idle within %s", drainTimeout),
+					Details: map[string]interface{}{"busySessionIDs": drainErr.BusySessions},
 				})
 				return
 			}
-			respondWithAPIError(c, apierrors.NewInternalError("drain_failed", err))
+			apierrors.Respond(c, apierrors.NewInternalError("drain_failed", err))
 			return
 		}
 	}
 
 	priorChangedAt, err := h.db.GetLastCredentialChangedAt(c.Request.Context(), workspaceID)
 	if err != nil {
-		respondWithAPIError(c, apierrors.NewInternalError("agent_state_read_failed", err))
+		apierrors.Respond(c, apierrors.NewInternalError("agent_state_read_failed", err))
 		return
 	}
 
@@ -262,13 +239,13 @@ func (h *AgentReloadHandler) Reload(c *gin.Context) {
 		if h.logger != nil {
 			h.logger.Error("agent reload: agentd unreachable", err)
 		}
-		respondWithAPIError(c, apierrors.NewInternalError("agent_unreachable", err))
+		apierrors.Respond(c, apierrors.NewInternalError("agent_unreachable", err))
 		return
 	}
 	defer resp.Body.Close() //nolint:errcheck
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4*1024))
-		respondWithAPIError(c, apierrors.NewInternalError("dispose_failed",
+		apierrors.Respond(c, apierrors.NewInternalError("dispose_failed",
 			fmt.Errorf("agentd returned %d: %s", resp.StatusCode, string(body)),
 		))
 		return
@@ -303,7 +280,7 @@ func (h *AgentReloadHandler) Reload(c *gin.Context) {
 	disposedAt, err := h.db.MarkAgentReloaded(c.Request.Context(), tx, workspaceID, priorChangedAt)
 	if err != nil {
 		if errors.Is(err, apierrors.ErrNoAgentStateRow) {
-			respondWithAPIError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		if h.logger != nil {
@@ -402,7 +379,7 @@ func (h *BulkReloadHandler) clearQueueOnDispose(ctx context.Context, workspaceID
 func (h *BulkReloadHandler) BulkReload(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -417,7 +394,7 @@ func (h *BulkReloadHandler) BulkReload(c *gin.Context) {
 
 	pending, err := h.pendingLister.ListPendingReloadWorkspaces(c.Request.Context(), userID)
 	if err != nil {
-		respondWithAPIError(c, apierrors.NewInternalError("list_pending_failed", err))
+		apierrors.Respond(c, apierrors.NewInternalError("list_pending_failed", err))
 		return
 	}
 
@@ -487,7 +464,7 @@ func (h *BulkReloadHandler) BulkReload(c *gin.Context) {
 }
 
 func (h *BulkReloadHandler) reloadOne(ctx context.Context, userID, workspaceID string, drain bool, drainTimeout time.Duration) map[string]any {
-	ws, err := h.workspaceSvc.GetWorkspace(ctx, userID, workspaceID)
+	ws, err := h.workspaceSvc.GetWorkspace(types.WithFreshWorkspaceRead(ctx), userID, workspaceID)
 	if err != nil {
 		return map[string]any{"workspaceId": workspaceID, "error": map[string]any{"code": "workspace_error", "message": err.Error()}}
 	}
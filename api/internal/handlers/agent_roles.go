@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/role"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -67,7 +68,7 @@ type updateRoleRequest struct {
 func (h *AgentRoleHandler) ListPlatform(c *gin.Context) {
 	roles, err := h.store.ListAgentRoles(c.Request.Context(), "platform", "")
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list platform roles"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list platform roles")
 		return
 	}
 	c.JSON(http.StatusOK, roles)
@@ -76,7 +77,7 @@ func (h *AgentRoleHandler) ListPlatform(c *gin.Context) {
 func (h *AgentRoleHandler) CreatePlatform(c *gin.Context) {
 	var req createRoleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -84,14 +85,14 @@ func (h *AgentRoleHandler) CreatePlatform(c *gin.Context) {
 
 	if req.Extends != nil && *req.Extends != "" {
 		if err := h.svc.ValidateExtends(c.Request.Context(), "platform", "", *req.Extends); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 			return
 		}
 	}
 
 	configJSON, err := types.MarshalRoleConfig(roleConfigOrDefault(req.Config))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid role config"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid role config")
 		return
 	}
 	created, err := h.store.CreateAgentRole(c.Request.Context(), &types.AgentRole{
@@ -103,7 +104,7 @@ func (h *AgentRoleHandler) CreatePlatform(c *gin.Context) {
 		IsDefault:   req.IsDefault,
 	}, configJSON)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create role")
 		return
 	}
 
@@ -116,7 +117,7 @@ func (h *AgentRoleHandler) CreatePlatform(c *gin.Context) {
 func (h *AgentRoleHandler) GetPlatform(c *gin.Context) {
 	r, err := h.store.GetAgentRole(c.Request.Context(), c.Param("id"))
 	if err != nil || r == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return
 	}
 	c.JSON(http.StatusOK, r)
@@ -126,7 +127,7 @@ func (h *AgentRoleHandler) GetOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	r, err := h.store.GetAgentRole(c.Request.Context(), c.Param("roleId"))
 	if err != nil || r == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return
 	}
 	if !h.assertOrgRole(c, orgID, r) {
@@ -143,7 +144,7 @@ func (h *AgentRoleHandler) GetOrg(c *gin.Context) {
 // leaking role existence across tenants) and returns false.
 func (h *AgentRoleHandler) assertOrgRole(c *gin.Context, orgID string, role *types.AgentRole) bool {
 	if role.Scope != "org" || role.OrgID == nil || *role.OrgID != orgID {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return false
 	}
 	return true
@@ -153,20 +154,20 @@ func (h *AgentRoleHandler) UpdatePlatform(c *gin.Context) {
 	roleID := c.Param("id")
 	var req updateRoleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	actorID := h.authSvc.GetUserID(c)
 	existing, err := h.store.GetAgentRole(c.Request.Context(), roleID)
 	if err != nil || existing == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return
 	}
 
 	if req.Extends != nil && *req.Extends != "" {
 		if err := h.svc.ValidateExtends(c.Request.Context(), "platform", "", *req.Extends); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 			return
 		}
 	}
@@ -174,13 +175,13 @@ func (h *AgentRoleHandler) UpdatePlatform(c *gin.Context) {
 	updated := applyUpdates(existing, &req)
 	configJSON, err := types.MarshalRoleConfig(&updated.Config)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid role config"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid role config")
 		return
 	}
 
 	result, err := h.store.UpdateAgentRole(c.Request.Context(), roleID, updated, configJSON)
 	if err != nil || result == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update role")
 		return
 	}
 
@@ -199,15 +200,15 @@ func (h *AgentRoleHandler) DeletePlatform(c *gin.Context) {
 		var inUse *role.RoleInUseError
 		switch {
 		case errors.As(err, &dre), errors.As(err, &inUse):
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusConflict, err.Error())
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check role dependencies"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check role dependencies")
 		}
 		return
 	}
 
 	if err := h.store.DeleteAgentRole(c.Request.Context(), roleID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete role")
 		return
 	}
 
@@ -223,7 +224,7 @@ func (h *AgentRoleHandler) ListOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	roles, err := h.store.ListAgentRoles(c.Request.Context(), "org", orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list org roles"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list org roles")
 		return
 	}
 	c.JSON(http.StatusOK, roles)
@@ -233,7 +234,7 @@ func (h *AgentRoleHandler) CreateOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	var req createRoleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -241,14 +242,14 @@ func (h *AgentRoleHandler) CreateOrg(c *gin.Context) {
 
 	if req.Extends != nil && *req.Extends != "" {
 		if err := h.svc.ValidateExtends(c.Request.Context(), "org", orgID, *req.Extends); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 			return
 		}
 	}
 
 	configJSON, err := types.MarshalRoleConfig(roleConfigOrDefault(req.Config))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid role config"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid role config")
 		return
 	}
 	created, err := h.store.CreateAgentRole(c.Request.Context(), &types.AgentRole{
@@ -263,13 +264,13 @@ func (h *AgentRoleHandler) CreateOrg(c *gin.Context) {
 		IsDefault: false,
 	}, configJSON)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create org role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create org role")
 		return
 	}
 
 	if req.IsDefault {
 		if err := h.store.SetOrgDefaultRole(c.Request.Context(), orgID, created.ID); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set default role"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set default role")
 			return
 		}
 	}
@@ -285,14 +286,14 @@ func (h *AgentRoleHandler) UpdateOrg(c *gin.Context) {
 	roleID := c.Param("roleId")
 	var req updateRoleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	actorID := h.authSvc.GetUserID(c)
 	existing, err := h.store.GetAgentRole(c.Request.Context(), roleID)
 	if err != nil || existing == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return
 	}
 	if !h.assertOrgRole(c, orgID, existing) {
@@ -301,7 +302,7 @@ func (h *AgentRoleHandler) UpdateOrg(c *gin.Context) {
 
 	if req.Extends != nil && *req.Extends != "" {
 		if err := h.svc.ValidateExtends(c.Request.Context(), "org", orgID, *req.Extends); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 			return
 		}
 	}
@@ -315,19 +316,19 @@ func (h *AgentRoleHandler) UpdateOrg(c *gin.Context) {
 	}
 	configJSON, err := types.MarshalRoleConfig(&updated.Config)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid role config"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid role config")
 		return
 	}
 
 	result, err := h.store.UpdateAgentRole(c.Request.Context(), roleID, updated, configJSON)
 	if err != nil || result == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update role")
 		return
 	}
 
 	if req.IsDefault != nil && *req.IsDefault {
 		if err := h.store.SetOrgDefaultRole(c.Request.Context(), orgID, roleID); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set default role"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set default role")
 			return
 		}
 	}
@@ -345,7 +346,7 @@ func (h *AgentRoleHandler) DeleteOrg(c *gin.Context) {
 
 	existing, err := h.store.GetAgentRole(c.Request.Context(), roleID)
 	if err != nil || existing == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return
 	}
 	if !h.assertOrgRole(c, orgID, existing) {
@@ -357,15 +358,15 @@ func (h *AgentRoleHandler) DeleteOrg(c *gin.Context) {
 		var inUse *role.RoleInUseError
 		switch {
 		case errors.As(err, &dre), errors.As(err, &inUse):
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusConflict, err.Error())
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check role dependencies"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check role dependencies")
 		}
 		return
 	}
 
 	if err := h.store.DeleteAgentRole(c.Request.Context(), roleID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete role")
 		return
 	}
 
@@ -381,7 +382,7 @@ func (h *AgentRoleHandler) GetWorkspaceRole(c *gin.Context) {
 	wsID := c.Param("id")
 	r, err := h.store.GetWorkspaceAgentRole(c.Request.Context(), wsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get workspace role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get workspace role")
 		return
 	}
 	if r == nil {
@@ -397,7 +398,7 @@ func (h *AgentRoleHandler) SetWorkspaceRole(c *gin.Context) {
 		RoleID string `json:"roleId" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "roleId required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "roleId required")
 		return
 	}
 
@@ -405,13 +406,13 @@ func (h *AgentRoleHandler) SetWorkspaceRole(c *gin.Context) {
 
 	r, err := h.store.GetAgentRole(c.Request.Context(), req.RoleID)
 	if err != nil || r == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "role not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "role not found")
 		return
 	}
 
 	orgID, err := h.store.GetWorkspaceOrgID(c.Request.Context(), wsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve workspace org"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve workspace org")
 		return
 	}
 
@@ -419,11 +420,11 @@ func (h *AgentRoleHandler) SetWorkspaceRole(c *gin.Context) {
 	if orgID != "" {
 		policies, err := h.store.GetOrgPolicies(c.Request.Context(), orgID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check org policy"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check org policy")
 			return
 		}
 		if !userPromptAllowedFromPolicies(policies) {
-			c.JSON(http.StatusForbidden, gin.H{"error": "org admin has disabled member role customization"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "org admin has disabled member role customization")
 			return
 		}
 	}
@@ -431,13 +432,13 @@ func (h *AgentRoleHandler) SetWorkspaceRole(c *gin.Context) {
 	// Stress test 1.3: scope validation
 	if r.Scope == "org" {
 		if r.OrgID == nil || *r.OrgID != orgID {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "cannot select role from a different org"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "cannot select role from a different org")
 			return
 		}
 	}
 
 	if err := h.store.SetWorkspaceAgentRole(c.Request.Context(), wsID, req.RoleID, actorID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set workspace role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set workspace role")
 		return
 	}
 
@@ -453,24 +454,24 @@ func (h *AgentRoleHandler) ClearWorkspaceRole(c *gin.Context) {
 
 	orgID, err := h.store.GetWorkspaceOrgID(c.Request.Context(), wsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve workspace org"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve workspace org")
 		return
 	}
 
 	if orgID != "" {
 		policies, err := h.store.GetOrgPolicies(c.Request.Context(), orgID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check org policy"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check org policy")
 			return
 		}
 		if !userPromptAllowedFromPolicies(policies) {
-			c.JSON(http.StatusForbidden, gin.H{"error": "org admin has disabled member role customization"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "org admin has disabled member role customization")
 			return
 		}
 	}
 
 	if err := h.store.ClearWorkspaceAgentRole(c.Request.Context(), wsID, actorID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to clear workspace role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to clear workspace role")
 		return
 	}
 
@@ -481,7 +482,7 @@ func (h *AgentRoleHandler) GetEffectiveWorkspaceRole(c *gin.Context) {
 	wsID := c.Param("id")
 	r, err := h.store.GetWorkspaceAgentRole(c.Request.Context(), wsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get workspace role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get workspace role")
 		return
 	}
 	if r == nil {
@@ -491,7 +492,7 @@ func (h *AgentRoleHandler) GetEffectiveWorkspaceRole(c *gin.Context) {
 
 	effective, err := h.svc.ResolveEffective(c.Request.Context(), r.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve effective role"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve effective role")
 		return
 	}
 	c.JSON(http.StatusOK, effective)
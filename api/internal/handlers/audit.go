@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
 
@@ -48,7 +49,7 @@ func (h *AuditHandler) List(c *gin.Context) {
 
 	entries, pagination, err := h.store.ListOrgAudit(c.Request.Context(), orgID, limit, offset)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list audit entries"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list audit entries")
 		return
 	}
 
@@ -90,7 +91,7 @@ func (h *AuditHandler) ListCrossOrg(c *gin.Context) {
 
 	entries, pagination, err := h.store.ListAllAudit(c.Request.Context(), filters)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list audit entries"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list audit entries")
 		return
 	}
 
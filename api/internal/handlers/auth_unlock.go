@@ -12,6 +12,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	pkgerrors "github.com/lenaxia/llmsafespaces/pkg/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
 )
@@ -50,7 +51,7 @@ func NewUnlockDEKHandler(keys DEKUnlocker) *UnlockDEKHandler {
 func (h *UnlockDEKHandler) Unlock(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -63,9 +64,8 @@ func (h *UnlockDEKHandler) Unlock(c *gin.Context) {
 	// otherwise drops through to a generic 500 — better to be explicit.
 	matchedSigningKey := extractMatchedSigningKey(c)
 	if matchedSigningKey == nil || isAPIKeySessionID(sessionID) {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "soft-unlock requires a JWT session",
-			"hint":  "API-key sessions store the wrapped DEK on the api_keys row itself (decrypt_access=true); no soft-unlock is needed.",
+		apierrors.RespondDetails(c, http.StatusBadRequest, "soft-unlock requires a JWT session", map[string]interface{}{
+			"hint": "API-key sessions store the wrapped DEK on the api_keys row itself (decrypt_access=true); no soft-unlock is needed.",
 		})
 		return
 	}
@@ -77,7 +77,7 @@ func (h *UnlockDEKHandler) Unlock(c *gin.Context) {
 		Password string `json:"password" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "password required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "password required")
 		return
 	}
 
@@ -88,7 +88,7 @@ func (h *UnlockDEKHandler) Unlock(c *gin.Context) {
 	if ttl <= 0 {
 		// Token effectively expired between AuthMiddleware accepting it
 		// and us reading exp. Reject so the client re-logs.
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "session expired; please log in again"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "session expired; please log in again")
 		return
 	}
 
@@ -100,7 +100,7 @@ func (h *UnlockDEKHandler) Unlock(c *gin.Context) {
 		// itself remains valid — the user just couldn't re-derive their
 		// DEK, so secret reads continue to fail until they retry.
 		if errors.Is(err, secrets.ErrInvalidPassword) {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid password"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "invalid password")
 			return
 		}
 		// Pre-Epic-10 users with no user_keys row reach this path with
@@ -108,10 +108,10 @@ func (h *UnlockDEKHandler) Unlock(c *gin.Context) {
 		// Other errors are server-side — surface as 500 without details.
 		var se *pkgerrors.StatusError
 		if errors.As(err, &se) {
-			c.JSON(se.Status, gin.H{"error": se.Message})
+			apierrors.RespondStatus(c, se.Status, se.Message)
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "unlock failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "unlock failed")
 		return
 	}
 
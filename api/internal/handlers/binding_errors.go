@@ -11,27 +11,27 @@ import (
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 )
 
-// bindingErrorResponse builds the response payload for a c.ShouldBindJSON
-// failure. It produces field-level details when the underlying error is a
+// bindingErrorResponse builds the error for a c.ShouldBindJSON failure. It
+// produces field-level details when the underlying error is a
 // validator.ValidationErrors (covering struct-tag validation failures like
 // missing fields, bad email, bad slug, etc.), and falls back to a generic
 // body-level error for malformed JSON or other binding failures.
 //
-// The returned shape is:
+// Both are 400s. Field-level failures carry code validation_error and
 //
-//	{
-//	  "error":   "validation failed" | "invalid request body",
-//	  "details": { "<jsonFieldName>": "<message>", ... }   // optional
-//	}
+//	"details": { "<jsonFieldName>": "<message>", ... }
 //
-// Keys in details use the JSON tag name on the struct (e.g. "ownerEmail") so
-// the frontend can highlight the right form field. The function never returns
-// nil — callers can pass the result straight to c.JSON.
-func bindingErrorResponse(err error, model any) map[string]any {
+// body-level ones carry bad_request and no details. Keys in details use the
+// JSON tag name on the struct (e.g. "ownerEmail") so the frontend can
+// highlight the right form field. The function never returns nil — callers
+// can pass the result straight to apierrors.Respond.
+func bindingErrorResponse(err error, model any) *apierrors.APIError {
 	if err == nil {
-		return map[string]any{"error": "invalid request body"}
+		return apierrors.NewBadRequestError("invalid request body", nil)
 	}
 
 	// JSON syntax/type errors come back as *json.SyntaxError or
@@ -40,12 +40,12 @@ func bindingErrorResponse(err error, model any) map[string]any {
 	var syntaxErr *json.SyntaxError
 	var unmarshalTypeErr *json.UnmarshalTypeError
 	if errors.As(err, &syntaxErr) || errors.As(err, &unmarshalTypeErr) {
-		return map[string]any{"error": "invalid request body"}
+		return apierrors.NewBadRequestError("invalid request body", err)
 	}
 
 	var verrs validator.ValidationErrors
 	if !errors.As(err, &verrs) {
-		return map[string]any{"error": "invalid request body"}
+		return apierrors.NewBadRequestError("invalid request body", err)
 	}
 
 	modelType := reflect.TypeOf(model)
@@ -53,15 +53,18 @@ func bindingErrorResponse(err error, model any) map[string]any {
 		modelType = modelType.Elem()
 	}
 
-	details := make(map[string]any, len(verrs))
+	details := make(map[string]interface{}, len(verrs))
 	for _, ve := range verrs {
 		key := jsonFieldName(modelType, ve.StructField())
 		details[key] = bindingErrorMessage(ve)
 	}
 
-	return map[string]any{
-		"error":   "validation failed",
-		"details": details,
+	return &apierrors.APIError{
+		Type:    apierrors.ErrorTypeBadRequest,
+		Code:    apierrors.CodeValidation,
+		Message: "validation failed",
+		Details: details,
+		Err:     err,
 	}
 }
 
@@ -94,11 +97,9 @@ func jsonFieldName(modelType reflect.Type, fieldName string) string {
 // getValidationErrorMessage() so the two paths stay consistent.
 //
 // DUPLICATION NOTE: This switch is intentionally duplicated with
-// getValidationErrorMessage in the middleware package. The two paths emit
-// different envelope shapes (this one: {"error": <str>, "details": {...}};
-// middleware: {"error": {"code", "message", "details"}}) so a shared
-// formatter would require new abstractions. They MUST be kept in sync: if
-// you add or change a case here, update the middleware version too.
+// getValidationErrorMessage in the middleware package, which supports
+// per-route custom messages this path does not need. They MUST be kept in
+// sync: if you add or change a case here, update the middleware version too.
 // See worklog 0557 follow-up for the centralized-validation story that will
 // collapse this duplication.
 func bindingErrorMessage(ve validator.FieldError) string {
@@ -13,6 +13,8 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 )
 
 // testBindModel is a fixture struct that exercises the slug, email, min,
@@ -32,7 +34,7 @@ func TestBindingErrorResponse_FieldLevel(t *testing.T) {
 	r.POST("/bind", func(c *gin.Context) {
 		var req testBindModel
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, bindingErrorResponse(err, &req))
+			apierrors.Respond(c, bindingErrorResponse(err, &req))
 			return
 		}
 		c.Status(http.StatusNoContent)
@@ -95,6 +97,9 @@ func TestBindingErrorResponse_FieldLevel(t *testing.T) {
 			if resp["error"] != "validation failed" {
 				t.Errorf("expected error 'validation failed', got %v", resp["error"])
 			}
+			if resp["code"] != apierrors.CodeValidation {
+				t.Errorf("expected code %q, got %v", apierrors.CodeValidation, resp["code"])
+			}
 			details, ok := resp["details"].(map[string]any)
 			if !ok {
 				t.Fatalf("expected details map, got %s", w.Body.String())
@@ -119,7 +124,7 @@ func TestBindingErrorResponse_MalformedJSON(t *testing.T) {
 	r.POST("/bind", func(c *gin.Context) {
 		var req testBindModel
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, bindingErrorResponse(err, &req))
+			apierrors.Respond(c, bindingErrorResponse(err, &req))
 			return
 		}
 		c.Status(http.StatusNoContent)
@@ -140,19 +145,23 @@ func TestBindingErrorResponse_MalformedJSON(t *testing.T) {
 	if resp["error"] != "invalid request body" {
 		t.Errorf("expected 'invalid request body' for malformed JSON, got %v", resp["error"])
 	}
+	if resp["code"] != apierrors.CodeBadRequest {
+		t.Errorf("expected code %q for malformed JSON, got %v", apierrors.CodeBadRequest, resp["code"])
+	}
 	if _, hasDetails := resp["details"]; hasDetails {
 		t.Errorf("malformed JSON must not return a details map (it has no fields to attribute), got %s", w.Body.String())
 	}
 }
 
 // TestBindingErrorResponse_NilError documents the fallback when called with
-// nil — defensive: never return nil so callers can pass straight to c.JSON.
+// nil — defensive: never return nil so callers can pass straight to
+// apierrors.Respond.
 func TestBindingErrorResponse_NilError(t *testing.T) {
 	out := bindingErrorResponse(nil, &testBindModel{})
 	if out == nil {
 		t.Fatal("must not return nil")
 	}
-	if out["error"] != "invalid request body" {
+	if out.Message != "invalid request body" {
 		t.Errorf("nil error should fall back to generic body-level error, got %v", out)
 	}
 }
@@ -161,10 +170,10 @@ func TestBindingErrorResponse_NilError(t *testing.T) {
 // that aren't validator.ValidationErrors or json syntax errors.
 func TestBindingErrorResponse_UnknownErrorType(t *testing.T) {
 	out := bindingErrorResponse(errors.New("something else"), &testBindModel{})
-	if out["error"] != "invalid request body" {
+	if out.Message != "invalid request body" {
 		t.Errorf("unknown error type should fall back to generic body-level error, got %v", out)
 	}
-	if _, hasDetails := out["details"]; hasDetails {
+	if out.Details != nil {
 		t.Errorf("unknown error type must not produce a details map, got %v", out)
 	}
 }
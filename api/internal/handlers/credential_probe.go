@@ -32,6 +32,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
 )
 
@@ -65,7 +66,7 @@ type ProbeModelsRequest struct {
 func ProbeModelsAnon(c *gin.Context) {
 	var req ProbeModelsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "apiKey and baseURL are required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "apiKey and baseURL are required")
 		return
 	}
 
@@ -74,14 +75,14 @@ func ProbeModelsAnon(c *gin.Context) {
 	// probes (GET /:id/models) are authenticated and the baseURL was user-supplied
 	// at credential create time — they rely on network policy for additional isolation.
 	if err := validateProbeBaseURL(req.BaseURL); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("baseURL rejected: %v", err)})
+		apierrors.RespondStatus(c, http.StatusBadRequest, fmt.Sprintf("baseURL rejected: %v", err))
 		return
 	}
 
 	pd := secrets.LLMProviderData{APIKey: req.APIKey, BaseURL: req.BaseURL}
 	plaintext, err := json.Marshal(pd) //nolint:gosec // G117: marshaling for probeCredentialModels, never returned to caller
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "internal error")
 		return
 	}
 	result := probeCredentialModels(c.Request.Context(), plaintext, probeCredentialLimits{})
@@ -255,7 +256,7 @@ func (h *AdminProviderCredentialsHandler) ProbeModels(c *gin.Context) {
 	}
 	plaintext, limits, perr := getCredentialForProbe(c.Request.Context(), h.store, "admin", "_platform", id, resolveDecrypt)
 	if perr != nil {
-		c.JSON(perr.status, gin.H{"error": perr.msg})
+		apierrors.RespondStatus(c, perr.status, perr.msg)
 		return
 	}
 	defer zeroBytes(plaintext)
@@ -267,7 +268,7 @@ func (h *AdminProviderCredentialsHandler) ProbeModels(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) ProbeModels(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" || sessionID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	matchedKey := extractMatchedSigningKey(c)
@@ -281,7 +282,7 @@ func (h *UserProviderCredentialsHandler) ProbeModels(c *gin.Context) {
 	}
 	plaintext, limits, perr := getCredentialForProbe(c.Request.Context(), h.store, "user", userID, c.Param("id"), resolveDecrypt)
 	if perr != nil {
-		c.JSON(perr.status, gin.H{"error": perr.msg})
+		apierrors.RespondStatus(c, perr.status, perr.msg)
 		return
 	}
 	defer zeroBytes(plaintext)
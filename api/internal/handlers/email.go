@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	emailsvc "github.com/lenaxia/llmsafespaces/api/internal/services/email"
 )
 
@@ -75,12 +76,12 @@ func (h *EmailHandler) TestSend(c *gin.Context) {
 		To string `json:"to" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "to is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "to is required")
 		return
 	}
 	parsed, err := mail.ParseAddress(strings.TrimSpace(req.To))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "to must be a valid email address"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "to must be a valid email address")
 		return
 	}
 	addr := parsed.Address
@@ -95,8 +96,7 @@ func (h *EmailHandler) TestSend(c *gin.Context) {
 		if uid != "" {
 			count, err := h.rl.Increment(c.Request.Context(), "email:test-send:"+uid, 1, testSendWindow)
 			if err == nil && count > testSendRateLimit {
-				c.JSON(http.StatusTooManyRequests, gin.H{
-					"error": "test-send rate limit exceeded",
+				apierrors.RespondDetails(c, http.StatusTooManyRequests, "test-send rate limit exceeded", map[string]interface{}{
 					"limit": testSendRateLimit,
 				})
 				return
@@ -117,7 +117,7 @@ func (h *EmailHandler) TestSend(c *gin.Context) {
 		if h.log != nil {
 			h.log.Error("email test-send failed", err, "to", addr, "provider", providerName)
 		}
-		c.JSON(http.StatusBadGateway, gin.H{"error": mapSESError(err)})
+		apierrors.RespondStatus(c, http.StatusBadGateway, mapSESError(err))
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"sent": true, "provider": providerName})
@@ -205,7 +205,7 @@ func TestEmailHandler_TestSend_RateLimited_AfterFiveCalls(t *testing.T) {
 
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
-	assert.Equal(t, float64(testSendRateLimit), resp["limit"])
+	assert.Equal(t, float64(testSendRateLimit), resp["details"].(map[string]any)["limit"])
 	assert.Contains(t, resp["error"], "rate limit")
 }
 
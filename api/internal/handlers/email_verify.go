@@ -15,6 +15,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/database"
 	emailsvc "github.com/lenaxia/llmsafespaces/api/internal/services/email"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
@@ -76,7 +77,7 @@ func (h *EmailVerifyHandler) Verify(c *gin.Context) {
 		Token string `json:"token" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "token is required")
 		return
 	}
 
@@ -85,35 +86,35 @@ func (h *EmailVerifyHandler) Verify(c *gin.Context) {
 
 	tok, err := h.store.GetEmailTokenByHash(ctx, hash)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify token"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify token")
 		return
 	}
 	if tok == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "token not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "token not found")
 		return
 	}
 	if tok.ConsumedAt != nil {
-		c.JSON(http.StatusGone, gin.H{"error": "token already used"})
+		apierrors.RespondStatus(c, http.StatusGone, "token already used")
 		return
 	}
 	if time.Now().After(tok.ExpiresAt) {
-		c.JSON(http.StatusGone, gin.H{"error": "token expired"})
+		apierrors.RespondStatus(c, http.StatusGone, "token expired")
 		return
 	}
 	if tok.Kind != "email_verify" {
-		c.JSON(http.StatusNotFound, gin.H{"error": "token not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "token not found")
 		return
 	}
 
 	if err := h.store.ConsumeEmailToken(ctx, tok.ID); err != nil {
 		if errors.Is(err, database.ErrTokenAlreadyConsumed) {
-			c.JSON(http.StatusGone, gin.H{"error": "token already used"})
+			apierrors.RespondStatus(c, http.StatusGone, "token already used")
 			return
 		}
 		if h.log != nil {
 			h.log.Error("verify-email: consume token DB error", err)
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to consume token"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to consume token")
 		return
 	}
 
@@ -122,7 +123,7 @@ func (h *EmailVerifyHandler) Verify(c *gin.Context) {
 		if h.log != nil {
 			h.log.Error("verify-email: failed to set email_verified", err, "user_id", tok.UserID)
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "verification failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "verification failed")
 		return
 	}
 
@@ -139,7 +140,7 @@ func (h *EmailVerifyHandler) Resend(c *gin.Context) {
 		Email string `json:"email" binding:"required,email"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "a valid email is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "a valid email is required")
 		return
 	}
 
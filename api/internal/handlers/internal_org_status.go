@@ -11,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
 
@@ -56,18 +57,18 @@ func (h *InternalOrgStatusHandler) GetOrgStatus(c *gin.Context) {
 		// endpoint without the token either, so 403 here means the chart has
 		// not wired LLMSAFESPACES_INTERNAL_TOKEN (a deployment misconfiguration),
 		// not a legitimate caller being blocked.
-		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "internal endpoint not configured"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "internal endpoint not configured")
 		return
 	}
 	// Constant-time compare to avoid leaking the shared secret via timing.
 	if subtle.ConstantTimeCompare([]byte(c.GetHeader("X-Internal-Token")), []byte(expected)) != 1 {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	orgID := c.Param("orgID")
 	if orgID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "orgID required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "orgID required")
 		return
 	}
 
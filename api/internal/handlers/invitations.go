@@ -17,6 +17,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/email"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -106,34 +107,36 @@ func (h *InvitationsHandler) Create(c *gin.Context) {
 
 	var req types.CreateInvitationsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 	if req.Role != types.OrgRoleAdmin && req.Role != types.OrgRoleMember {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "role must be 'admin' or 'member'"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "role must be 'admin' or 'member'")
 		return
 	}
 
 	count, err := h.store.CountInvitationsLastHour(ctx, orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check rate limit"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check rate limit")
 		return
 	}
 	if count+len(req.Emails) > maxInvitationsPerHr {
-		c.JSON(http.StatusTooManyRequests, gin.H{"error": "invitation rate limit exceeded", "limit": maxInvitationsPerHr})
+		apierrors.RespondDetails(c, http.StatusTooManyRequests, "invitation rate limit exceeded", map[string]interface{}{
+			"limit": maxInvitationsPerHr,
+		})
 		return
 	}
 
 	created := make([]*types.OrgInvitation, 0, len(req.Emails))
 	org, err := h.store.GetOrg(ctx, orgID)
 	if err != nil || org == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get organization")
 		return
 	}
 	for _, addr := range req.Emails {
 		token, hash, err := generateInvitationToken()
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate token"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to generate token")
 			return
 		}
 		inv := &types.OrgInvitation{
@@ -146,7 +149,9 @@ func (h *InvitationsHandler) Create(c *gin.Context) {
 			ExpiresAt: time.Now().Add(invitationExpiry),
 		}
 		if err := h.store.CreateInvitation(ctx, inv); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create invitation", "email": addr})
+			apierrors.RespondDetails(c, http.StatusInternalServerError, "failed to create invitation", map[string]interface{}{
+				"email": addr,
+			})
 			return
 		}
 		created = append(created, inv)
@@ -161,7 +166,7 @@ func (h *InvitationsHandler) List(c *gin.Context) {
 	orgID := c.Param("id")
 	invitations, err := h.store.ListPendingInvitations(c.Request.Context(), orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list invitations"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list invitations")
 		return
 	}
 	c.JSON(http.StatusOK, invitations)
@@ -173,15 +178,15 @@ func (h *InvitationsHandler) Delete(c *gin.Context) {
 	invID := c.Param("invID")
 	existing, err := h.store.GetInvitationByID(c.Request.Context(), invID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get invitation")
 		return
 	}
 	if existing == nil || existing.OrgID != orgID {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 	if err := h.store.DeleteInvitation(c.Request.Context(), invID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to revoke invitation")
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -196,25 +201,25 @@ func (h *InvitationsHandler) Resend(c *gin.Context) {
 
 	existing, err := h.store.GetInvitationByID(ctx, invID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get invitation")
 		return
 	}
 	if existing == nil || existing.OrgID != orgID {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 	if existing.AcceptedAt != nil || existing.DeclinedAt != nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "cannot resend an already accepted or declined invitation"})
+		apierrors.RespondStatus(c, http.StatusConflict, "cannot resend an already accepted or declined invitation")
 		return
 	}
 	if existing.BounceType == "permanent" || existing.BounceType == "complaint" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "email address has a permanent bounce; cannot resend"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "email address has a permanent bounce; cannot resend")
 		return
 	}
 
 	token, hash, err := generateInvitationToken()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate token"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to generate token")
 		return
 	}
 	inv := &types.OrgInvitation{
@@ -227,7 +232,7 @@ func (h *InvitationsHandler) Resend(c *gin.Context) {
 		ExpiresAt: time.Now().Add(invitationExpiry),
 	}
 	if err := h.store.CreateInvitation(ctx, inv); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create invitation")
 		return
 	}
 	// Invalidate the old invitation AFTER the new one is persisted so a
@@ -271,41 +276,42 @@ func (h *InvitationsHandler) VerifyUserForInvitation(c *gin.Context) {
 
 	inv, err := h.store.GetInvitationByID(ctx, invID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get invitation")
 		return
 	}
 	// Cross-org invitations are reported as 404, not 403, so admins of org A
 	// cannot probe whether an invitation exists in org B.
 	if inv == nil || inv.OrgID != orgID {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 	if inv.AcceptedAt != nil || inv.DeclinedAt != nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "invitation is no longer pending"})
+		apierrors.RespondStatus(c, http.StatusConflict, "invitation is no longer pending")
 		return
 	}
 	if time.Now().After(inv.ExpiresAt) {
-		c.JSON(http.StatusGone, gin.H{"error": "invitation expired"})
+		apierrors.RespondStatus(c, http.StatusGone, "invitation expired")
 		return
 	}
 
 	userID, err := h.store.GetUserIDByEmail(ctx, strings.ToLower(strings.TrimSpace(inv.Email)))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve user by email"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve user by email")
 		return
 	}
 	if userID == "" {
 		// Distinct status (422) and machine-parseable error code so the
 		// frontend can render a specific "user must sign up first" message.
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"error":   "no_account_for_email",
-			"message": "no account exists for this email yet; the invitee must sign up before you can verify them",
+		apierrors.Respond(c, &apierrors.APIError{
+			Type:    apierrors.ErrorTypeValidation,
+			Code:    apierrors.CodeNoAccountForEmail,
+			Message: "no account exists for this email yet; the invitee must sign up before you can verify them",
 		})
 		return
 	}
 
 	if err := h.store.MarkUserEmailVerified(ctx, userID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify user"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify user")
 		return
 	}
 
@@ -337,17 +343,17 @@ func (h *InvitationsHandler) GetByToken(c *gin.Context) {
 	hash := hashToken(token)
 	inv, err := h.store.GetInvitationByTokenHash(ctx, hash)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get invitation")
 		return
 	}
 	if inv == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 
 	org, err := h.store.GetOrg(ctx, inv.OrgID)
 	if err != nil || org == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 
@@ -374,29 +380,29 @@ func (h *InvitationsHandler) Accept(c *gin.Context) {
 	hash := hashToken(token)
 	inv, err := h.store.GetInvitationByTokenHash(ctx, hash)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get invitation")
 		return
 	}
 	if inv == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 	if inv.AcceptedAt != nil || inv.DeclinedAt != nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "invitation already accepted or declined"})
+		apierrors.RespondStatus(c, http.StatusConflict, "invitation already accepted or declined")
 		return
 	}
 	if time.Now().After(inv.ExpiresAt) {
-		c.JSON(http.StatusGone, gin.H{"error": "invitation expired"})
+		apierrors.RespondStatus(c, http.StatusGone, "invitation expired")
 		return
 	}
 
 	existing, err := h.store.GetOrgMember(ctx, inv.OrgID, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check membership")
 		return
 	}
 	if existing != nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "user is already a member of this org"})
+		apierrors.RespondStatus(c, http.StatusConflict, "user is already a member of this org")
 		return
 	}
 
@@ -405,11 +411,11 @@ func (h *InvitationsHandler) Accept(c *gin.Context) {
 	// constraint violation on insert. Pre-check here to return a clear 409.
 	currentOrgID, err := h.store.GetUserOrgID(ctx, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check existing org membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check existing org membership")
 		return
 	}
 	if currentOrgID != "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "user is already a member of another organization"})
+		apierrors.RespondStatus(c, http.StatusConflict, "user is already a member of another organization")
 		return
 	}
 
@@ -418,25 +424,25 @@ func (h *InvitationsHandler) Accept(c *gin.Context) {
 	// different account.
 	userEmail, err := h.store.GetUserEmail(ctx, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify user email"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify user email")
 		return
 	}
 	if !strings.EqualFold(userEmail, inv.Email) {
-		c.JSON(http.StatusForbidden, gin.H{"error": "this invitation was sent to a different email address"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "this invitation was sent to a different email address")
 		return
 	}
 
 	member, alreadyTaken, err := h.store.AcceptInvitationTx(ctx, inv.ID, userID, inv.Role)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to accept invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to accept invitation")
 		return
 	}
 	if alreadyTaken {
-		c.JSON(http.StatusConflict, gin.H{"error": "invitation already accepted or declined"})
+		apierrors.RespondStatus(c, http.StatusConflict, "invitation already accepted or declined")
 		return
 	}
 	if member == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 
@@ -468,16 +474,16 @@ func (h *InvitationsHandler) Decline(c *gin.Context) {
 	hash := hashToken(token)
 	inv, err := h.store.GetInvitationByTokenHash(ctx, hash)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get invitation")
 		return
 	}
 	if inv == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "invitation not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "invitation not found")
 		return
 	}
 
 	if err := h.store.DeclineInvitation(ctx, inv.ID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to decline invitation"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to decline invitation")
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"status": "declined"})
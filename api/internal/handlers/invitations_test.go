@@ -961,8 +961,8 @@ func TestInvitations_VerifyUser_NoUserAccount(t *testing.T) {
 	}
 	var body map[string]string
 	_ = json.Unmarshal(w.Body.Bytes(), &body)
-	if body["error"] != "no_account_for_email" {
-		t.Errorf("error code must be machine-parseable 'no_account_for_email' for the frontend's switch on it; got %q", body["error"])
+	if body["code"] != "no_account_for_email" {
+		t.Errorf("error code must be machine-parseable 'no_account_for_email' for the frontend's switch on it; got %q", body["code"])
 	}
 	if len(store.markVerifiedCalls) != 0 {
 		t.Errorf("MarkUserEmailVerified must NOT be called when no user exists; got %v", store.markVerifiedCalls)
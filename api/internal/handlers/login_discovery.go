@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
 
@@ -92,7 +93,7 @@ func (h *LoginDiscoveryHandler) Lookup(c *gin.Context) {
 		Email string `json:"email" binding:"required,email"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "a valid email is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "a valid email is required")
 		return
 	}
 
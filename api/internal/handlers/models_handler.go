@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/agent/opencode"
 	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
@@ -84,12 +85,12 @@ func (h *ModelsHandler) ListModels(c *gin.Context) {
 	workspaceID := c.Param("id")
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	if h.agentClient == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "model discovery unavailable"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "model discovery unavailable")
 		return
 	}
 
@@ -146,10 +147,10 @@ func (h *ModelsHandler) ListModels(c *gin.Context) {
 		body, err := h.agentClient.ListModels(c.Request.Context(), userID, workspaceID)
 		if err != nil {
 			if errors.Is(err, opencode.ErrNoRunningPod) {
-				c.JSON(http.StatusNotFound, gin.H{"error": "workspace pod not running"})
+				apierrors.RespondStatus(c, http.StatusNotFound, "workspace pod not running")
 				return
 			}
-			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to reach agent"})
+			apierrors.RespondStatus(c, http.StatusBadGateway, "failed to reach agent")
 			return
 		}
 
@@ -212,23 +213,23 @@ func (h *ModelsHandler) SetModel(c *gin.Context) {
 	workspaceID := c.Param("id")
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	var req ModelSelectionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "model field is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "model field is required")
 		return
 	}
 
 	if h.wsUpdater == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "model selection unavailable"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "model selection unavailable")
 		return
 	}
 
 	if h.agentClient == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "model selection unavailable (no agent client)"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "model selection unavailable (no agent client)")
 		return
 	}
 
@@ -241,7 +242,7 @@ func (h *ModelsHandler) SetModel(c *gin.Context) {
 		}
 	}
 	if catalog != nil && !catalog.modelExists(req.Model) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "model not found in workspace catalog"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "model not found in workspace catalog")
 		return
 	}
 	// If pod not running, skip validation (store optimistically).
@@ -250,7 +251,7 @@ func (h *ModelsHandler) SetModel(c *gin.Context) {
 	if err := h.wsUpdater.UpdateWorkspace(c.Request.Context(), workspaceID, types.WorkspaceUpdates{
 		DefaultModel: &req.Model,
 	}); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update workspace"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update workspace")
 		return
 	}
 
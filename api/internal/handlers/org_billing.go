@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/billing"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -60,17 +61,17 @@ func (h *OrgsHandler) Checkout(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	if h.billing == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "billing is not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "billing is not configured")
 		return
 	}
 
 	org, err := h.orgStore.GetOrg(ctx, orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get organization")
 		return
 	}
 	if org == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "organization not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "organization not found")
 		return
 	}
 
@@ -78,23 +79,23 @@ func (h *OrgsHandler) Checkout(c *gin.Context) {
 		PlanID types.OrgPlan `json:"planId" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	customerID, err := h.resolveCustomerID(ctx, org)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve billing customer"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve billing customer")
 		return
 	}
 	if customerID == "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "no billing customer linked to this organization"})
+		apierrors.RespondStatus(c, http.StatusConflict, "no billing customer linked to this organization")
 		return
 	}
 
 	url, err := h.billing.CreateCheckoutSession(ctx, customerID, string(req.PlanID), h.successURL, h.cancelURL)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create checkout session"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create checkout session")
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"url": url})
@@ -107,23 +108,23 @@ func (h *OrgsHandler) Portal(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	if h.billing == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "billing is not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "billing is not configured")
 		return
 	}
 
 	customerID, err := h.resolveCustomerID(ctx, &types.Organization{ID: orgID})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve billing customer"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve billing customer")
 		return
 	}
 	if customerID == "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "no billing customer linked to this organization"})
+		apierrors.RespondStatus(c, http.StatusConflict, "no billing customer linked to this organization")
 		return
 	}
 
 	url, err := h.billing.CreatePortalSession(ctx, customerID, h.portalURL)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create portal session"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create portal session")
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"url": url})
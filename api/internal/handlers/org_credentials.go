@@ -14,6 +14,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
 )
 
@@ -69,7 +70,7 @@ func (h *OrgCredentialsHandler) Create(c *gin.Context) {
 
 	var req createOrgCredentialRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -77,22 +78,26 @@ func (h *OrgCredentialsHandler) Create(c *gin.Context) {
 	// (Epic 55). Without this, an invalid kind/slug reaches the DB and
 	// the CHECK constraint fires as opaque 500 instead of 400.
 	if err := secrets.ValidateKind(req.Kind); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "kind"})
+		apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+			"field": "kind",
+		})
 		return
 	}
 	if err := secrets.ValidateSlug(req.Slug); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "slug"})
+		apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+			"field": "slug",
+		})
 		return
 	}
 
 	if h.provider == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "server key not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "server key not configured")
 		return
 	}
 
 	ciphertext, err := encryptCredentialData(ctx, h.provider.Encrypt, req.Kind, req.Slug, req.APIKey, req.BaseURL)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to encode credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to encode credential")
 		return
 	}
 
@@ -128,14 +133,14 @@ func (h *OrgCredentialsHandler) Create(c *gin.Context) {
 	if err := h.credStore.CreateCredential(ctx, "org", orgID, row); err != nil {
 		classified := ClassifyPostgresError(err)
 		if errors.Is(classified, ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "credential with this slug already exists"})
+			apierrors.RespondStatus(c, http.StatusConflict, "credential with this slug already exists")
 			return
 		}
 		if errors.Is(classified, ErrCredentialCheckViolation) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "credential failed validation; kind or slug is invalid"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "credential failed validation; kind or slug is invalid")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create credential")
 		return
 	}
 
@@ -167,7 +172,7 @@ func (h *OrgCredentialsHandler) List(c *gin.Context) {
 	orgID := c.Param("id")
 	rows, err := h.credStore.ListCredentials(c.Request.Context(), "org", orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list credentials"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list credentials")
 		return
 	}
 	// The unified ListCredentials returns ASC (matching admin/user). The org
@@ -190,31 +195,35 @@ func (h *OrgCredentialsHandler) Update(c *gin.Context) {
 
 	var req updateOrgCredentialRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	// Validate kind/slug if the caller is updating them (Epic 55).
 	if req.Kind != nil {
 		if err := secrets.ValidateKind(*req.Kind); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "kind"})
+			apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+				"field": "kind",
+			})
 			return
 		}
 	}
 	if req.Slug != nil {
 		if err := secrets.ValidateSlug(*req.Slug); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "slug"})
+			apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+				"field": "slug",
+			})
 			return
 		}
 	}
 
 	existing, err := h.credStore.GetCredential(ctx, "org", orgID, credID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to retrieve credential")
 		return
 	}
 	if existing == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 
@@ -231,19 +240,19 @@ func (h *OrgCredentialsHandler) Update(c *gin.Context) {
 	// has always included Kind/Slug here for this reason).
 	if req.APIKey != nil || req.BaseURL != nil || req.Kind != nil || req.Slug != nil {
 		if h.provider == nil {
-			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "server key not configured"})
+			apierrors.RespondStatus(c, http.StatusServiceUnavailable, "server key not configured")
 			return
 		}
 
 		oldPlaintext, err := h.provider.Decrypt(ctx, existing.Ciphertext)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to decrypt existing credential"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to decrypt existing credential")
 			return
 		}
 		defer zeroBytes(oldPlaintext) // zero on all exit paths (success and failure)
 		var pd secrets.LLMProviderData
 		if err := json.Unmarshal(oldPlaintext, &pd); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to decode credential"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to decode credential")
 			return
 		}
 		if req.Kind != nil {
@@ -260,13 +269,13 @@ func (h *OrgCredentialsHandler) Update(c *gin.Context) {
 		}
 		newPlaintext, err := json.Marshal(pd) //nolint:gosec // G117 false positive — pd contains encrypted credential data
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to encode credential"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to encode credential")
 			return
 		}
 		newCiphertext, err = h.provider.Encrypt(ctx, newPlaintext)
 		zeroBytes(newPlaintext)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "re-encryption failed"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "re-encryption failed")
 			return
 		}
 		newKeyVersion++
@@ -309,14 +318,14 @@ func (h *OrgCredentialsHandler) Update(c *gin.Context) {
 	if err := h.credStore.UpdateCredential(ctx, "org", orgID, credID, upd); err != nil {
 		classified := ClassifyPostgresError(err)
 		if errors.Is(classified, ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "a credential with this slug already exists in this organization"})
+			apierrors.RespondStatus(c, http.StatusConflict, "a credential with this slug already exists in this organization")
 			return
 		}
 		if errors.Is(classified, ErrCredentialCheckViolation) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "credential failed validation; kind or slug is invalid"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "credential failed validation; kind or slug is invalid")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update credential")
 		return
 	}
 
@@ -342,7 +351,7 @@ func (h *OrgCredentialsHandler) Delete(c *gin.Context) {
 			c.Status(http.StatusNoContent)
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete credential")
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -365,7 +374,7 @@ func (h *OrgCredentialsHandler) ProbeModels(c *gin.Context) {
 	}
 	plaintext, limits, perr := getCredentialForProbe(ctx, h.credStore, "org", orgID, credID, resolveDecrypt)
 	if perr != nil {
-		c.JSON(perr.status, gin.H{"error": perr.msg})
+		apierrors.RespondStatus(c, perr.status, perr.msg)
 		return
 	}
 	defer zeroBytes(plaintext)
@@ -380,20 +389,20 @@ func (h *OrgCredentialsHandler) CreateAutoApply(c *gin.Context) {
 
 	cred, err := h.credStore.GetCredential(ctx, "org", orgID, credID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify credential")
 		return
 	}
 	if cred == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found in this organization"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found in this organization")
 		return
 	}
 
 	if err := h.orgOps.CreateOrgAutoApply(ctx, credID, orgID, 5); err != nil {
 		if errors.Is(ClassifyPostgresError(err), ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "auto-apply rule already exists"})
+			apierrors.RespondStatus(c, http.StatusConflict, "auto-apply rule already exists")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create auto-apply rule"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create auto-apply rule")
 		return
 	}
 	c.Status(http.StatusCreated)
@@ -408,18 +417,18 @@ func (h *OrgCredentialsHandler) ListAutoApply(c *gin.Context) {
 	if credID != "" {
 		cred, err := h.credStore.GetCredential(ctx, "org", orgID, credID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify credential"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify credential")
 			return
 		}
 		if cred == nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "credential not found in this organization"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "credential not found in this organization")
 			return
 		}
 	}
 
 	rules, err := h.orgOps.ListOrgAutoApply(ctx, orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list auto-apply rules"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list auto-apply rules")
 		return
 	}
 	if rules == nil {
@@ -433,7 +442,7 @@ func (h *OrgCredentialsHandler) DeleteAutoApply(c *gin.Context) {
 	orgID := c.Param("id")
 	credID := c.Param("credID")
 	if err := h.orgOps.DeleteOrgAutoApply(c.Request.Context(), credID, orgID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete auto-apply rule"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete auto-apply rule")
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -1143,7 +1143,7 @@ func TestOrgCredentials_Create_InvalidKind_400(t *testing.T) {
 		"invalid kind must surface as 400 from the handler boundary, not 500 from the DB CHECK")
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
-	assert.Equal(t, "kind", resp["field"])
+	assert.Equal(t, "kind", resp["details"].(map[string]any)["field"])
 }
 
 // TestOrgCredentials_Create_InvalidSlug_400 — same for slug.
@@ -1166,7 +1166,7 @@ func TestOrgCredentials_Create_InvalidSlug_400(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, w.Code)
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
-	assert.Equal(t, "slug", resp["field"])
+	assert.Equal(t, "slug", resp["details"].(map[string]any)["field"])
 }
 
 // TestOrgCredentials_Update_InvalidKind_400 — validation also fires on the
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/sso"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -92,7 +93,7 @@ func (h *SSOHandler) Get(c *gin.Context) {
 	orgID := c.Param("id")
 	cfg, err := h.store.GetSSOConfig(c.Request.Context(), orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load sso config"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to load sso config")
 		return
 	}
 	if cfg == nil {
@@ -115,7 +116,7 @@ func (h *SSOHandler) Put(c *gin.Context) {
 
 	var req types.UpsertSSOConfigRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -124,7 +125,7 @@ func (h *SSOHandler) Put(c *gin.Context) {
 	// current verified_domains for the intersection computation (D17 Q-S2).
 	existing, err := h.store.GetSSOConfig(c.Request.Context(), orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load existing sso config"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to load existing sso config")
 		return
 	}
 	var existingSecret []byte
@@ -158,7 +159,7 @@ func (h *SSOHandler) Delete(c *gin.Context) {
 	orgID := c.Param("id")
 	actorID := h.authSvc.GetUserID(c)
 	if err := h.store.DeleteSSOConfig(c.Request.Context(), orgID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete sso config"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete sso config")
 		return
 	}
 	if err := h.store.LogOrgEvent(c.Request.Context(), orgID, actorID, "sso.delete", orgID, nil); err != nil && h.logger != nil {
@@ -199,10 +200,10 @@ func (h *SSOHandler) RotateToken(c *gin.Context) {
 	token, err := h.svc.RotateToken(c.Request.Context(), orgID)
 	if err != nil {
 		if errors.Is(err, sso.ErrSSONotConfigured) {
-			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusNotFound, err.Error())
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to rotate verification token"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to rotate verification token")
 		return
 	}
 	if err := h.store.LogOrgEvent(c.Request.Context(), orgID, actorID, "sso.token.rotate", orgID, nil); err != nil && h.logger != nil {
@@ -215,15 +216,15 @@ func (h *SSOHandler) RotateToken(c *gin.Context) {
 func respondVerifyError(c *gin.Context, err error) {
 	switch {
 	case errors.Is(err, sso.ErrSSONotConfigured):
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusNotFound, err.Error())
 	case errors.Is(err, sso.ErrDomainNotClaimed):
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 	case errors.Is(err, sso.ErrNoVerificationToken):
-		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusConflict, err.Error())
 	case errors.Is(err, sso.ErrDNSNotMatching):
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusUnprocessableEntity, err.Error())
 	default:
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "domain verification is currently unavailable"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "domain verification is currently unavailable")
 	}
 }
 
@@ -234,7 +235,7 @@ func (h *SSOHandler) Start(c *gin.Context) {
 	redirectURL, err := h.resolveCallbackURL(c, orgSlug)
 	if err != nil {
 		// F11: redirect base URL unset — refuse rather than trust X-Forwarded-*.
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "SSO is not fully configured: set oidc.redirectBaseUrl"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "SSO is not fully configured: set oidc.redirectBaseUrl")
 		return
 	}
 
@@ -244,13 +245,13 @@ func (h *SSOHandler) Start(c *gin.Context) {
 		// wrapped DB/discovery error is logged internally and surfaced as a
 		// generic message to avoid leaking internals.
 		if errors.Is(err, sso.ErrSSONotConfigured) {
-			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusNotFound, err.Error())
 			return
 		}
 		if h.logger != nil {
 			h.logger.Warn("sso start failed", "orgSlug", orgSlug, "error", err.Error())
 		}
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "single sign-on is currently unavailable"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "single sign-on is currently unavailable")
 		return
 	}
 	h.setStateCookie(c, res.Cookie)
@@ -293,7 +294,7 @@ func (h *SSOHandler) Callback(c *gin.Context) {
 func (h *SSOHandler) Domains(c *gin.Context) {
 	domains, err := h.store.ListSSODomains(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list sso domains"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list sso domains")
 		return
 	}
 	if domains == nil {
@@ -395,12 +396,12 @@ func respondSSOMutationError(c *gin.Context, err error) {
 	msg := err.Error()
 	switch {
 	case strings.Contains(msg, "server key not configured"):
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": msg})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, msg)
 	case strings.Contains(msg, "client secret is required"):
-		c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+		apierrors.RespondStatus(c, http.StatusBadRequest, msg)
 	case strings.Contains(msg, "invalid role"):
-		c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+		apierrors.RespondStatus(c, http.StatusBadRequest, msg)
 	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save sso config"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to save sso config")
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
 
@@ -115,17 +116,17 @@ func (h *OrgsHandler) GetOrg(ctx context.Context, orgID string) (*types.Organiza
 func (h *OrgsHandler) Create(c *gin.Context) {
 	callerID := h.authSvc.GetUserID(c)
 	if callerID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	if !isPlatformAdmin(c) {
-		c.JSON(http.StatusForbidden, gin.H{"error": "only platform admins can create organizations"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "only platform admins can create organizations")
 		return
 	}
 
 	var req types.CreateOrgRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, bindingErrorResponse(err, &req))
+		apierrors.Respond(c, bindingErrorResponse(err, &req))
 		return
 	}
 	req.Slug = strings.ToLower(req.Slug)
@@ -135,11 +136,11 @@ func (h *OrgsHandler) Create(c *gin.Context) {
 
 	ownerID, err := h.orgStore.GetUserIDByEmail(ctx, ownerEmail)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve owner"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve owner")
 		return
 	}
 	if ownerID == "" {
-		c.JSON(http.StatusNotFound, gin.H{"error": "owner not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "owner not found")
 		return
 	}
 
@@ -149,21 +150,21 @@ func (h *OrgsHandler) Create(c *gin.Context) {
 	// would misclassify as "slug already in use". Pre-check for a clear 409.
 	existingOrgID, err := h.orgStore.GetUserOrgID(ctx, ownerID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check owner org membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check owner org membership")
 		return
 	}
 	if existingOrgID != "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "owner is already a member of another organization"})
+		apierrors.RespondStatus(c, http.StatusConflict, "owner is already a member of another organization")
 		return
 	}
 
 	existing, err := h.orgStore.GetOrgBySlug(ctx, req.Slug)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check slug uniqueness"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check slug uniqueness")
 		return
 	}
 	if existing != nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "slug already in use"})
+		apierrors.RespondStatus(c, http.StatusConflict, "slug already in use")
 		return
 	}
 
@@ -177,10 +178,10 @@ func (h *OrgsHandler) Create(c *gin.Context) {
 	created, err := h.orgStore.CreateOrgWithAdmin(ctx, newOrg, ownerID)
 	if err != nil {
 		if errors.Is(ClassifyPostgresError(err), ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "slug already in use"})
+			apierrors.RespondStatus(c, http.StatusConflict, "slug already in use")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to create organization")
 		return
 	}
 
@@ -191,7 +192,7 @@ func (h *OrgsHandler) Create(c *gin.Context) {
 	active := types.OrgStatusActive
 	sub := types.SubscriptionActive
 	if err := h.orgStore.UpdateOrgStatus(ctx, created.ID, &active, &sub, &plan); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to activate organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to activate organization")
 		return
 	}
 	created.Status = active
@@ -220,13 +221,13 @@ func (h *OrgsHandler) Create(c *gin.Context) {
 func (h *OrgsHandler) List(c *gin.Context) {
 	userID := h.authSvc.GetUserID(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	orgs, err := h.orgStore.ListOrgsForUser(c.Request.Context(), userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list organizations"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list organizations")
 		return
 	}
 
@@ -238,7 +239,7 @@ func (h *OrgsHandler) Get(c *gin.Context) {
 	orgID := c.Param("id")
 	userID := h.authSvc.GetUserID(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -246,27 +247,27 @@ func (h *OrgsHandler) Get(c *gin.Context) {
 
 	org, err := h.orgStore.GetOrg(ctx, orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get organization")
 		return
 	}
 	if org == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "organization not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "organization not found")
 		return
 	}
 
 	member, err := h.orgStore.GetOrgMember(ctx, orgID, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get membership")
 		return
 	}
 	if member == nil {
-		c.JSON(http.StatusForbidden, gin.H{"error": "not a member of this organization"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "not a member of this organization")
 		return
 	}
 
 	orgs, err := h.orgStore.ListOrgsForUser(ctx, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get org details"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get org details")
 		return
 	}
 
@@ -290,7 +291,7 @@ func (h *OrgsHandler) Update(c *gin.Context) {
 
 	var req types.UpdateOrgRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, bindingErrorResponse(err, &req))
+		apierrors.Respond(c, bindingErrorResponse(err, &req))
 		return
 	}
 	// Lowercase the slug before any uniqueness check or persistence — mirrors
@@ -306,11 +307,11 @@ func (h *OrgsHandler) Update(c *gin.Context) {
 	if req.Slug != "" {
 		existing, err := h.orgStore.GetOrgBySlug(ctx, req.Slug)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check slug uniqueness"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check slug uniqueness")
 			return
 		}
 		if existing != nil && existing.ID != orgID {
-			c.JSON(http.StatusConflict, gin.H{"error": "slug already in use"})
+			apierrors.RespondStatus(c, http.StatusConflict, "slug already in use")
 			return
 		}
 	}
@@ -318,21 +319,21 @@ func (h *OrgsHandler) Update(c *gin.Context) {
 	updated, err := h.orgStore.UpdateOrg(ctx, orgID, req)
 	if err != nil {
 		if errors.Is(ClassifyPostgresError(err), ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "slug already in use"})
+			apierrors.RespondStatus(c, http.StatusConflict, "slug already in use")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update organization")
 		return
 	}
 	if updated == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "organization not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "organization not found")
 		return
 	}
 
 	userID := h.authSvc.GetUserID(c)
 	orgs, err := h.orgStore.ListOrgsForUser(ctx, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get org details"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get org details")
 		return
 	}
 	for _, o := range orgs {
@@ -355,7 +356,7 @@ func (h *OrgsHandler) Delete(c *gin.Context) {
 	// impossible. Workspaces now become frozen (org_id retained, IsOrgMember
 	// returns false for deleted orgs) instead of blocking deletion.
 	if err := h.orgStore.SoftDeleteOrg(ctx, orgID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete organization")
 		return
 	}
 
@@ -384,7 +385,7 @@ func (h *OrgsHandler) ListWorkspaces(c *gin.Context) {
 
 	workspaces, pagination, err := h.orgStore.ListOrgWorkspaces(c.Request.Context(), orgID, limit, offset)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list org workspaces"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list org workspaces")
 		return
 	}
 
@@ -399,7 +400,7 @@ func (h *OrgsHandler) ListMembers(c *gin.Context) {
 	orgID := c.Param("id")
 	members, err := h.orgStore.ListOrgMembers(c.Request.Context(), orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list members"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list members")
 		return
 	}
 	if members == nil {
@@ -415,22 +416,22 @@ func (h *OrgsHandler) AddMember(c *gin.Context) {
 
 	var req types.AddOrgMemberRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, bindingErrorResponse(err, &req))
+		apierrors.Respond(c, bindingErrorResponse(err, &req))
 		return
 	}
 
 	if req.Role != types.OrgRoleAdmin && req.Role != types.OrgRoleMember {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "role must be 'admin' or 'member'"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "role must be 'admin' or 'member'")
 		return
 	}
 
 	existing, err := h.orgStore.GetOrgMember(ctx, orgID, req.UserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check membership")
 		return
 	}
 	if existing != nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "user is already a member"})
+		apierrors.RespondStatus(c, http.StatusConflict, "user is already a member")
 		return
 	}
 
@@ -439,16 +440,16 @@ func (h *OrgsHandler) AddMember(c *gin.Context) {
 	// Pre-check to return a clear 409.
 	currentOrgID, err := h.orgStore.GetUserOrgID(ctx, req.UserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check existing org membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check existing org membership")
 		return
 	}
 	if currentOrgID != "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "user is already a member of another organization"})
+		apierrors.RespondStatus(c, http.StatusConflict, "user is already a member of another organization")
 		return
 	}
 
 	if err := h.orgStore.AddOrgMember(ctx, orgID, req.UserID, req.Role); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to add member"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to add member")
 		return
 	}
 
@@ -463,33 +464,33 @@ func (h *OrgsHandler) RemoveMember(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	if targetUserID == callerUserID {
-		c.JSON(http.StatusConflict, gin.H{"error": "org admins cannot remove themselves; transfer admin role first"})
+		apierrors.RespondStatus(c, http.StatusConflict, "org admins cannot remove themselves; transfer admin role first")
 		return
 	}
 
 	targetMember, err := h.orgStore.GetOrgMember(ctx, orgID, targetUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check membership")
 		return
 	}
 	if targetMember == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "member not found")
 		return
 	}
 
 	if targetMember.Role == types.OrgRoleAdmin {
 		removed, err := h.orgStore.RemoveOrgAdminIfNotLast(ctx, orgID, targetUserID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to remove admin"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to remove admin")
 			return
 		}
 		if !removed {
-			c.JSON(http.StatusConflict, gin.H{"error": "cannot remove the last org admin"})
+			apierrors.RespondStatus(c, http.StatusConflict, "cannot remove the last org admin")
 			return
 		}
 	} else {
 		if err := h.orgStore.RemoveOrgMember(ctx, orgID, targetUserID); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to remove member"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to remove member")
 			return
 		}
 	}
@@ -506,33 +507,33 @@ func (h *OrgsHandler) ChangeMemberRole(c *gin.Context) {
 
 	var req types.ChangeOrgMemberRoleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, bindingErrorResponse(err, &req))
+		apierrors.Respond(c, bindingErrorResponse(err, &req))
 		return
 	}
 
 	if req.Role != types.OrgRoleAdmin && req.Role != types.OrgRoleMember {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "role must be 'admin' or 'member'"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "role must be 'admin' or 'member'")
 		return
 	}
 
 	target, err := h.orgStore.GetOrgMember(ctx, orgID, targetUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check membership")
 		return
 	}
 	if target == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "member not found")
 		return
 	}
 
 	if target.Role == req.Role {
-		c.JSON(http.StatusConflict, gin.H{"error": "member already has this role"})
+		apierrors.RespondStatus(c, http.StatusConflict, "member already has this role")
 		return
 	}
 
 	if req.Role == types.OrgRoleAdmin {
 		if err := h.orgStore.UpdateOrgMemberRole(ctx, orgID, targetUserID, types.OrgRoleAdmin); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to promote member"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to promote member")
 			return
 		}
 		c.JSON(http.StatusOK, gin.H{"message": "Member role updated"})
@@ -540,17 +541,17 @@ func (h *OrgsHandler) ChangeMemberRole(c *gin.Context) {
 	}
 
 	if targetUserID == callerUserID {
-		c.JSON(http.StatusConflict, gin.H{"error": "org admins cannot demote themselves"})
+		apierrors.RespondStatus(c, http.StatusConflict, "org admins cannot demote themselves")
 		return
 	}
 
 	demoted, err := h.orgStore.DemoteOrgAdminIfNotLast(ctx, orgID, targetUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to demote admin"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to demote admin")
 		return
 	}
 	if !demoted {
-		c.JSON(http.StatusConflict, gin.H{"error": "cannot demote the last org admin"})
+		apierrors.RespondStatus(c, http.StatusConflict, "cannot demote the last org admin")
 		return
 	}
 
@@ -576,16 +577,16 @@ func (h *OrgsHandler) VerifyMember(c *gin.Context) {
 
 	target, err := h.orgStore.GetOrgMember(ctx, orgID, targetUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check membership"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check membership")
 		return
 	}
 	if target == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "member not found")
 		return
 	}
 
 	if err := h.orgStore.MarkUserEmailVerified(ctx, targetUserID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify member"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify member")
 		return
 	}
 
@@ -1004,6 +1004,9 @@ func TestOrgsHandler_AddMember_MissingUserIdReturnsDetails(t *testing.T) {
 	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
+	if resp["code"] != "validation_error" {
+		t.Errorf("expected code validation_error, got: %s", w.Body.String())
+	}
 	details, ok := resp["details"].(map[string]any)
 	if !ok {
 		t.Fatalf("expected details object, got: %s", w.Body.String())
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/database"
 	emailsvc "github.com/lenaxia/llmsafespaces/api/internal/services/email"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
@@ -138,7 +139,7 @@ func (h *PasswordResetHandler) Request(c *gin.Context) {
 		Email string `json:"email" binding:"required,email"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "a valid email is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "a valid email is required")
 		return
 	}
 
@@ -214,7 +215,7 @@ func (h *PasswordResetHandler) Confirm(c *gin.Context) {
 		NewPassword string `json:"newPassword" binding:"required,min=8"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "token and newPassword (min 8 chars) are required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "token and newPassword (min 8 chars) are required")
 		return
 	}
 
@@ -223,23 +224,23 @@ func (h *PasswordResetHandler) Confirm(c *gin.Context) {
 
 	tok, err := h.store.GetEmailTokenByHash(ctx, hash)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify token"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify token")
 		return
 	}
 	if tok == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "token not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "token not found")
 		return
 	}
 	if tok.ConsumedAt != nil {
-		c.JSON(http.StatusGone, gin.H{"error": "token already used"})
+		apierrors.RespondStatus(c, http.StatusGone, "token already used")
 		return
 	}
 	if time.Now().After(tok.ExpiresAt) {
-		c.JSON(http.StatusGone, gin.H{"error": "token expired"})
+		apierrors.RespondStatus(c, http.StatusGone, "token expired")
 		return
 	}
 	if tok.Kind != "password_reset" {
-		c.JSON(http.StatusNotFound, gin.H{"error": "token not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "token not found")
 		return
 	}
 
@@ -248,13 +249,13 @@ func (h *PasswordResetHandler) Confirm(c *gin.Context) {
 	// (not the sentinel) returns 500 so it's distinguishable from consumption.
 	if err := h.store.ConsumeEmailToken(ctx, tok.ID); err != nil {
 		if errors.Is(err, database.ErrTokenAlreadyConsumed) {
-			c.JSON(http.StatusGone, gin.H{"error": "token already used"})
+			apierrors.RespondStatus(c, http.StatusGone, "token already used")
 			return
 		}
 		if h.log != nil {
 			h.log.Error("password-reset: consume token DB error", err)
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to consume token"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to consume token")
 		return
 	}
 
@@ -266,7 +267,7 @@ func (h *PasswordResetHandler) Confirm(c *gin.Context) {
 		if h.log != nil {
 			h.log.Error("password-reset: bcrypt update failed", err, "user_id", tok.UserID)
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "password reset failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "password reset failed")
 		return
 	}
 
@@ -280,7 +281,7 @@ func (h *PasswordResetHandler) Confirm(c *gin.Context) {
 		if h.log != nil {
 			h.log.Error("password-reset: DEK reinit failed", err, "user_id", tok.UserID)
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "password reset failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "password reset failed")
 		return
 	}
 
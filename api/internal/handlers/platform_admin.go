@@ -11,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
 
@@ -42,6 +43,11 @@ type platformUserRevoker interface {
 	ClearUserSuspended(ctx context.Context, userID string) error
 }
 
+// platformUserImporter bulk-creates users (*auth.Service satisfies it).
+type platformUserImporter interface {
+	ImportUsers(ctx context.Context, records []types.ImportUserRecord) (*types.ImportUsersResult, error)
+}
+
 // PlatformAdminHandler implements the platform-admin org/user suspension
 // endpoints (D19, US-43.19). All routes are mounted behind AuthMiddleware +
 // AdminGuard (users.role='admin'), so every method here runs in a
@@ -51,6 +57,7 @@ type PlatformAdminHandler struct {
 	userStore platformAdminUserStore
 	authSvc   orgAuthService
 	revoker   platformUserRevoker
+	importer  platformUserImporter
 	logger    policyLogger
 }
 
@@ -62,6 +69,10 @@ func NewPlatformAdminHandler(orgs platformAdminOrgStore, users platformAdminUser
 	return &PlatformAdminHandler{orgStore: orgs, userStore: users, authSvc: authSvc, revoker: revoker, logger: logger}
 }
 
+// SetUserImporter wires the bulk user import. Without it ImportUsers
+// responds 501.
+func (h *PlatformAdminHandler) SetUserImporter(i platformUserImporter) { h.importer = i }
+
 // SuspendOrg handles POST /api/v1/admin/orgs/:id/suspend.
 //
 // Sets organizations.status='suspended'. Per D20 the operational effect (pod
@@ -72,14 +83,14 @@ func NewPlatformAdminHandler(orgs platformAdminOrgStore, users platformAdminUser
 func (h *PlatformAdminHandler) SuspendOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	if orgID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "org id required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "org id required")
 		return
 	}
 	actorID := h.authSvc.GetUserID(c)
 	ctx := c.Request.Context()
 
 	if err := h.orgStore.UpdateOrgStatus(ctx, orgID, statusPtr(types.OrgStatusSuspended), nil, nil); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to suspend organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to suspend organization")
 		return
 	}
 	h.emitAudit(ctx, "org", "org.suspend", orgID, &orgID, actorID, nil)
@@ -93,14 +104,14 @@ func (h *PlatformAdminHandler) SuspendOrg(c *gin.Context) {
 func (h *PlatformAdminHandler) UnsuspendOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	if orgID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "org id required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "org id required")
 		return
 	}
 	actorID := h.authSvc.GetUserID(c)
 	ctx := c.Request.Context()
 
 	if err := h.orgStore.UpdateOrgStatus(ctx, orgID, statusPtr(types.OrgStatusActive), nil, nil); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to unsuspend organization"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to unsuspend organization")
 		return
 	}
 	h.emitAudit(ctx, "org", "org.unsuspend", orgID, &orgID, actorID, nil)
@@ -117,7 +128,7 @@ func (h *PlatformAdminHandler) UnsuspendOrg(c *gin.Context) {
 func (h *PlatformAdminHandler) SuspendUser(c *gin.Context) {
 	userID := c.Param("id")
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "user id required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "user id required")
 		return
 	}
 	actorID := h.authSvc.GetUserID(c)
@@ -126,12 +137,11 @@ func (h *PlatformAdminHandler) SuspendUser(c *gin.Context) {
 
 	conflict, err := h.orgStore.SuspendUserGuardedByLastAdmin(ctx, userID, force)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to suspend user"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to suspend user")
 		return
 	}
 	if conflict != nil {
-		c.JSON(http.StatusConflict, gin.H{
-			"error": "cannot suspend last admin of org " + conflict.OrgName + " — promote another member first (use ?force=true to override)",
+		apierrors.RespondDetails(c, http.StatusConflict, "cannot suspend last admin of org "+conflict.OrgName+" — promote another member first (use ?force=true to override)", map[string]interface{}{
 			"orgId": conflict.OrgID,
 		})
 		return
@@ -160,14 +170,14 @@ func (h *PlatformAdminHandler) SuspendUser(c *gin.Context) {
 func (h *PlatformAdminHandler) UnsuspendUser(c *gin.Context) {
 	userID := c.Param("id")
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "user id required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "user id required")
 		return
 	}
 	actorID := h.authSvc.GetUserID(c)
 	ctx := c.Request.Context()
 
 	if err := h.userStore.SetUserStatus(ctx, userID, types.UserStatusActive); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to unsuspend user"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to unsuspend user")
 		return
 	}
 	// F4: clear the revocation marker so the user's existing tokens work again
@@ -195,7 +205,7 @@ func (h *PlatformAdminHandler) ListOrgs(c *gin.Context) {
 
 	orgs, pagination, err := h.orgStore.ListAllOrgs(c.Request.Context(), limit, offset, statusFilter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list organizations"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list organizations")
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{
@@ -216,7 +226,7 @@ func (h *PlatformAdminHandler) ListUsers(c *gin.Context) {
 
 	users, pagination, err := h.userStore.ListAllUsers(c.Request.Context(), limit, offset, statusFilter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list users"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list users")
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{
@@ -225,6 +235,36 @@ func (h *PlatformAdminHandler) ListUsers(c *gin.Context) {
 	})
 }
 
+// ImportUsers handles POST /api/v1/admin/users/import.
+//
+// Records are validated and created independently; the response is 200 with
+// a per-record outcome even when some records fail. Only a malformed body or
+// an empty/oversized batch is rejected outright. One audit event summarises
+// the batch.
+func (h *PlatformAdminHandler) ImportUsers(c *gin.Context) {
+	if h.importer == nil {
+		apierrors.RespondStatus(c, http.StatusNotImplemented, "user import is not available")
+		return
+	}
+	var req types.ImportUsersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	ctx := c.Request.Context()
+
+	result, err := h.importer.ImportUsers(ctx, req.Users)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	h.emitAudit(ctx, "admin", "user.import", "", nil, h.authSvc.GetUserID(c), map[string]any{
+		"created": result.Created,
+		"failed":  result.Failed,
+	})
+	c.JSON(http.StatusOK, result)
+}
+
 // parseAdminListPaging extracts limit/offset for an admin list endpoint.
 // limit defaults to 50 and is clamped to (0, 200]; negative/non-numeric values
 // fall back to the default. offset defaults to 0 and clamps to >= 0.
@@ -0,0 +1,104 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+type mockUserImporter struct {
+	got    []types.ImportUserRecord
+	result *types.ImportUsersResult
+	err    error
+}
+
+func (m *mockUserImporter) ImportUsers(_ context.Context, records []types.ImportUserRecord) (*types.ImportUsersResult, error) {
+	m.got = records
+	return m.result, m.err
+}
+
+func setupImportRouter(t *testing.T, orgs *mockPlatformOrgStore, importer platformUserImporter) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	h := NewPlatformAdminHandler(orgs, &mockPlatformUserStore{}, &mockOrgAuthService{userID: "admin-1"}, nil, &stubLogger{})
+	if importer != nil {
+		h.SetUserImporter(importer)
+	}
+	r := gin.New()
+	r.POST("/api/v1/admin/users/import", h.ImportUsers)
+	return r
+}
+
+func TestImportUsers_ReturnsPerRecordResults(t *testing.T) {
+	orgs := &mockPlatformOrgStore{}
+	importer := &mockUserImporter{result: &types.ImportUsersResult{
+		Created: 1,
+		Failed:  1,
+		Results: []types.ImportUserResult{
+			{Index: 0, Email: "a@example.com", Status: types.ImportUserCreated, UserID: "u-1", Role: "user"},
+			{Index: 1, Email: "bad", Status: types.ImportUserFailed, Error: "invalid email address"},
+		},
+	}}
+	r := setupImportRouter(t, orgs, importer)
+
+	w := doRequest(r, "POST", "/api/v1/admin/users/import",
+		`{"users":[{"username":"alice","email":"a@example.com","password":"password123"},{"username":"bad","email":"bad","password":"password123"}]}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200 for a partially failed batch, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(importer.got) != 2 {
+		t.Fatalf("expected 2 records passed to importer, got %d", len(importer.got))
+	}
+
+	var body types.ImportUsersResult
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.Created != 1 || body.Failed != 1 || len(body.Results) != 2 {
+		t.Fatalf("unexpected summary: %+v", body)
+	}
+	if body.Results[1].Error != "invalid email address" {
+		t.Errorf("expected per-record error, got %+v", body.Results[1])
+	}
+
+	if len(orgs.auditCalls) != 1 || orgs.auditCalls[0].Action != "user.import" || orgs.auditCalls[0].ActorID != "admin-1" {
+		t.Errorf("expected one user.import audit event, got %+v", orgs.auditCalls)
+	}
+}
+
+func TestImportUsers_BatchValidationError(t *testing.T) {
+	importer := &mockUserImporter{err: apierrors.NewValidationError("users must not be empty", nil, nil)}
+	r := setupImportRouter(t, &mockPlatformOrgStore{}, importer)
+
+	w := doRequest(r, "POST", "/api/v1/admin/users/import", `{"users":[]}`)
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestImportUsers_MalformedBody(t *testing.T) {
+	r := setupImportRouter(t, &mockPlatformOrgStore{}, &mockUserImporter{})
+
+	w := doRequest(r, "POST", "/api/v1/admin/users/import", `{"users":`)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestImportUsers_NoImporter_501(t *testing.T) {
+	r := setupImportRouter(t, &mockPlatformOrgStore{}, nil)
+
+	w := doRequest(r, "POST", "/api/v1/admin/users/import", `{"users":[]}`)
+	if w.Code != http.StatusNotImplemented {
+		t.Fatalf("expected 501, got %d", w.Code)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/prompt"
 	"github.com/lenaxia/llmsafespaces/pkg/interfaces"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
@@ -158,7 +159,7 @@ func (h *PodBootstrapHandler) HasLogger() bool {
 func (h *PodBootstrapHandler) Bootstrap(c *gin.Context) {
 	token := extractBearerToken(c.GetHeader("Authorization"))
 	if token == "" {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing authorization"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "missing authorization")
 		return
 	}
 
@@ -167,10 +168,10 @@ func (h *PodBootstrapHandler) Bootstrap(c *gin.Context) {
 		// C1: distinguish "token rejected by apiserver" (401, client error)
 		// from "TokenReview API call failed" (500, server fault).
 		if errors.Is(err, errTokenNotAuthenticated) {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token not authenticated"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "token not authenticated")
 			return
 		}
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "token review failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "token review failed")
 		return
 	}
 
@@ -178,7 +179,7 @@ func (h *PodBootstrapHandler) Bootstrap(c *gin.Context) {
 		WorkspaceID string `json:"workspaceID"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil || req.WorkspaceID == "" {
-		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "workspaceID required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "workspaceID required")
 		return
 	}
 
@@ -186,17 +187,17 @@ func (h *PodBootstrapHandler) Bootstrap(c *gin.Context) {
 	// A token from a different namespace's workspace-<id> SA must be rejected.
 	saNamespace, saWorkspaceID, ok := parseSAPrincipal(username)
 	if !ok || saWorkspaceID != req.WorkspaceID || saNamespace != h.expectedNamespace {
-		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "workspace identity mismatch"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "workspace identity mismatch")
 		return
 	}
 
 	ws, err := h.lookup.GetWorkspace(c.Request.Context(), req.WorkspaceID)
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "workspace lookup failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "workspace lookup failed")
 		return
 	}
 	if ws == nil {
-		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 		return
 	}
 
@@ -213,7 +214,7 @@ func (h *PodBootstrapHandler) Bootstrap(c *gin.Context) {
 				"workspaceID", req.WorkspaceID,
 				"userID", ws.UserID)
 		}
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "secret preparation failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "secret preparation failed")
 		return
 	}
 	if len(secretsJSON) == 0 {
@@ -224,7 +225,7 @@ func (h *PodBootstrapHandler) Bootstrap(c *gin.Context) {
 	if ws.DefaultModel != "" {
 		cfgJSON, err := json.Marshal(types.WorkspaceConfig{DefaultModel: ws.DefaultModel})
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "workspace config marshal failed"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "workspace config marshal failed")
 			return
 		}
 		resp.WorkspaceConfig = cfgJSON
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/policy"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -45,7 +46,7 @@ func (h *PolicyHandler) Get(c *gin.Context) {
 	orgID := c.Param("id")
 	policies, err := h.store.GetOrgPolicies(c.Request.Context(), orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get policies"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get policies")
 		return
 	}
 	c.JSON(http.StatusOK, policies)
@@ -59,22 +60,22 @@ func (h *PolicyHandler) Put(c *gin.Context) {
 
 	key := types.OrgPolicyKey(rawKey)
 	if !isValidKey(key) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy key"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid policy key")
 		return
 	}
 
 	var body json.RawMessage
 	if err := c.ShouldBindJSON(&body); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 	if !isValidValue(key, body) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy value for key"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid policy value for key")
 		return
 	}
 
 	if err := h.store.SetOrgPolicy(c.Request.Context(), orgID, key, body, userID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set policy"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set policy")
 		return
 	}
 	if err := h.store.LogOrgEvent(c.Request.Context(), orgID, userID, "policy.set", string(key), map[string]any{"value": body}); err != nil && h.logger != nil {
@@ -94,12 +95,12 @@ func (h *PolicyHandler) Delete(c *gin.Context) {
 
 	key := types.OrgPolicyKey(rawKey)
 	if !isValidKey(key) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy key"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid policy key")
 		return
 	}
 
 	if err := h.store.DeleteOrgPolicy(c.Request.Context(), orgID, key); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete policy"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete policy")
 		return
 	}
 	actorID := h.authSvc.GetUserID(c)
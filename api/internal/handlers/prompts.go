@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/prompt"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -48,7 +49,7 @@ type platformPromptResponse struct {
 func (h *PromptHandler) GetPlatform(c *gin.Context) {
 	setting, err := h.store.GetPlatformSetting(c.Request.Context(), types.SettingSysPromptPlatform)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get platform prompt"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get platform prompt")
 		return
 	}
 	var promptText string
@@ -66,7 +67,7 @@ type setPlatformPromptRequest struct {
 func (h *PromptHandler) SetPlatform(c *gin.Context) {
 	var req setPlatformPromptRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -74,7 +75,7 @@ func (h *PromptHandler) SetPlatform(c *gin.Context) {
 	actorID := h.authSvc.GetUserID(c)
 
 	if err := h.store.SetPlatformSetting(c.Request.Context(), types.SettingSysPromptPlatform, value, actorID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set platform prompt"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set platform prompt")
 		return
 	}
 	if err := h.store.LogAuditEvent(c.Request.Context(), "admin", actorID, "prompt.platform.set", "sys_prompt_platform", nil, map[string]any{"length": len(req.Prompt)}); err != nil && h.logger != nil {
@@ -96,7 +97,7 @@ func (h *PromptHandler) GetOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	policies, err := h.store.GetOrgPolicies(c.Request.Context(), orgID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get org prompt"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get org prompt")
 		return
 	}
 
@@ -122,7 +123,7 @@ func (h *PromptHandler) SetOrg(c *gin.Context) {
 	orgID := c.Param("id")
 	var req setOrgPromptRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -131,7 +132,7 @@ func (h *PromptHandler) SetOrg(c *gin.Context) {
 	if req.Prompt != nil {
 		value, _ := json.Marshal(*req.Prompt)
 		if err := h.store.SetOrgPolicy(c.Request.Context(), orgID, types.PolicySysPromptOrg, value, actorID); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set org prompt"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set org prompt")
 			return
 		}
 		if err := h.store.LogOrgEvent(c.Request.Context(), orgID, actorID, "prompt.org.set", "sys_prompt_org", map[string]any{"length": len(*req.Prompt)}); err != nil && h.logger != nil {
@@ -142,7 +143,7 @@ func (h *PromptHandler) SetOrg(c *gin.Context) {
 	if req.AllowUserPrompt != nil {
 		value, _ := json.Marshal(*req.AllowUserPrompt)
 		if err := h.store.SetOrgPolicy(c.Request.Context(), orgID, types.PolicyAllowUserPrompt, value, actorID); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set allow_user_prompt"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set allow_user_prompt")
 			return
 		}
 		if err := h.store.LogOrgEvent(c.Request.Context(), orgID, actorID, "prompt.toggle", "allow_user_prompt", map[string]any{"value": *req.AllowUserPrompt}); err != nil && h.logger != nil {
@@ -168,7 +169,7 @@ func (h *PromptHandler) GetWorkspacePrompt(c *gin.Context) {
 	wsID := c.Param("id")
 	wp, err := h.store.GetWorkspacePrompt(c.Request.Context(), wsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get workspace prompt"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get workspace prompt")
 		return
 	}
 	prompt := ""
@@ -206,7 +207,7 @@ func (h *PromptHandler) SetWorkspacePrompt(c *gin.Context) {
 	wsID := c.Param("id")
 	var req setWorkspacePromptRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -215,23 +216,23 @@ func (h *PromptHandler) SetWorkspacePrompt(c *gin.Context) {
 	// Check allow_user_prompt policy
 	orgID, err := h.store.GetWorkspaceOrgID(c.Request.Context(), wsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve workspace org"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resolve workspace org")
 		return
 	}
 	if orgID != "" {
 		policies, err := h.store.GetOrgPolicies(c.Request.Context(), orgID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check org policy"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check org policy")
 			return
 		}
 		if !userPromptAllowedFromPolicies(policies) {
-			c.JSON(http.StatusForbidden, gin.H{"error": "org admin has disabled member prompt customization"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "org admin has disabled member prompt customization")
 			return
 		}
 	}
 
 	if err := h.store.SetWorkspacePrompt(c.Request.Context(), wsID, req.Prompt, actorID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set workspace prompt"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set workspace prompt")
 		return
 	}
 
@@ -17,6 +17,7 @@ import (
 	"github.com/gin-gonic/gin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/interfaces"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/activity"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/eventbroker"
@@ -75,6 +76,11 @@ type ProxyHandler struct {
 	userBroker      *eventbroker.UserEventBroker
 	sessionParents  *sessionParentCache
 
+	// usageHistory holds recent per-workspace CPU/memory samples in the
+	// shared cache, fed by the CRD watcher and served by
+	// GetWorkspaceMetrics. Nil (no cache) serves an empty series.
+	usageHistory *workspace.UsageHistory
+
 	meteringSvc interfaces.MeteringService
 
 	// versionSyncCb is the callback wired into the CRD watcher to persist
@@ -181,7 +187,7 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 ) {
 	workspaceID := c.Param("id")
 	if workspaceID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "workspace ID required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "workspace ID required")
 		return
 	}
 
@@ -195,22 +201,21 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 		v1Client, v1Err := h.k8sClient.LlmsafespacesV1()
 		if v1Err != nil {
 			h.logger.Error("Failed to get LLMSafespacesV1 client", v1Err, "workspaceID", workspaceID)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "internal error")
 			return
 		}
 		var err error
 		workspace, err = v1Client.Workspaces(h.namespace).Get(c.Request.Context(), workspaceID, metav1.GetOptions{})
 		if err != nil {
 			h.logger.Error("Failed to get workspace CRD", err, "workspaceID", workspaceID)
-			c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 			return
 		}
 	}
 
 	if workspace.Status.Phase != phaseActive || workspace.Status.PodIP == "" {
 		c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-		c.JSON(http.StatusServiceUnavailable, gin.H{
-			"error":      "workspace not ready",
+		apierrors.RespondDetails(c, http.StatusServiceUnavailable, "workspace not ready", map[string]interface{}{
 			"phase":      workspace.Status.Phase,
 			"retryAfter": retryAfterSec,
 		})
@@ -220,7 +225,7 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 	password, err := h.getPassword(c.Request.Context(), workspaceID)
 	if err != nil {
 		h.logger.Error("Failed to get workspace password", err, "workspaceID", workspaceID)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve workspace credentials"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to retrieve workspace credentials")
 		return
 	}
 
@@ -231,8 +236,7 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 
 	if !h.acquireConnection(workspaceID) {
 		c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-		c.JSON(http.StatusTooManyRequests, gin.H{
-			"error":      "connection limit reached",
+		apierrors.RespondDetails(c, http.StatusTooManyRequests, "connection limit reached", map[string]interface{}{
 			"retryAfter": retryAfterSec,
 		})
 		return
@@ -247,8 +251,7 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 	if isWriteOp && sessionID != "" {
 		if !h.checkAndAddActiveSession(c.Request.Context(), workspaceID, sessionID, maxSessions) {
 			c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"error":             "active session limit reached",
+			apierrors.RespondDetails(c, http.StatusTooManyRequests, "active session limit reached", map[string]interface{}{
 				"maxActiveSessions": maxSessions,
 				"retryAfter":        retryAfterSec,
 			})
@@ -268,11 +271,11 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 		if err != nil {
 			var maxErr *http.MaxBytesError
 			if errors.As(err, &maxErr) {
-				c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body exceeds 10 MB limit"})
+				apierrors.RespondStatus(c, http.StatusRequestEntityTooLarge, "request body exceeds 10 MB limit")
 				return
 			}
 			h.logger.Error("Failed to read request body", err, "workspaceID", workspaceID)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "failed to read request body")
 			return
 		}
 	}
@@ -331,7 +334,7 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 			if isWriteOp && sessionID != "" {
 				h.removeActiveSession(c.Request.Context(), workspaceID, sessionID)
 			}
-			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests during restart, please try again"})
+			apierrors.RespondStatus(c, http.StatusTooManyRequests, "Too many requests during restart, please try again")
 			return
 		}
 		// A parked request holds no upstream socket, so release the connection
@@ -364,10 +367,12 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 			}
 			if !c.Writer.Written() && c.Request.Context().Err() == nil {
 				if errors.Is(ferr, errBufferTimeout) {
-					c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Workspace is restarting, please try again in a moment"})
+					apierrors.RespondStatus(c, http.StatusServiceUnavailable, "Workspace is restarting, please try again in a moment")
 				} else {
 					c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-					c.JSON(http.StatusServiceUnavailable, gin.H{"error": "workspace connection failed", "retryAfter": retryAfterSec})
+					apierrors.RespondDetails(c, http.StatusServiceUnavailable, "workspace connection failed", map[string]interface{}{
+						"retryAfter": retryAfterSec,
+					})
 				}
 			}
 			return
@@ -381,8 +386,7 @@ func (h *ProxyHandler) proxyToWorkspaceWithErrBody(
 		}
 		if !c.Writer.Written() {
 			c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-			c.JSON(http.StatusServiceUnavailable, gin.H{
-				"error":      "workspace connection failed",
+			apierrors.RespondDetails(c, http.StatusServiceUnavailable, "workspace connection failed", map[string]interface{}{
 				"retryAfter": retryAfterSec,
 			})
 		}
@@ -482,8 +486,7 @@ func (h *ProxyHandler) doProxy(c *gin.Context, podIP, targetPath, password strin
 		h.invalidateCaches(c.Request.Context(), wsID)
 		h.logger.Warn("Upstream auth failed; password cache invalidated",
 			"workspaceID", wsID, "path", targetPath)
-		c.JSON(http.StatusBadGateway, gin.H{
-			"error":       "upstream authentication failed; please retry",
+		apierrors.RespondDetails(c, http.StatusBadGateway, "upstream authentication failed; please retry", map[string]interface{}{
 			"workspaceID": wsID,
 		})
 		return nil
@@ -598,7 +601,9 @@ func (h *ProxyHandler) checkProxyQuota(c *gin.Context, workspace *v1.Workspace)
 	}
 	if !allowed {
 		metrics.RecordQuotaExceeded("llm_request")
-		c.JSON(http.StatusTooManyRequests, gin.H{"error": "quota exceeded", "event_type": "llm_request"})
+		apierrors.RespondDetails(c, http.StatusTooManyRequests, "quota exceeded", map[string]interface{}{
+			"event_type": "llm_request",
+		})
 		return false
 	}
 	return true
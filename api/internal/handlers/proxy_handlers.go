@@ -20,6 +20,7 @@ import (
 	"github.com/gin-gonic/gin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/msgqueue"
 	apitypes "github.com/lenaxia/llmsafespaces/api/internal/types"
 	"github.com/lenaxia/llmsafespaces/pkg/agentd"
@@ -37,7 +38,7 @@ func (h *ProxyHandler) ListSessions(c *gin.Context) {
 func (h *ProxyHandler) SendMessage(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	wid := c.Param("id")
@@ -73,14 +74,13 @@ func (h *ProxyHandler) SendMessage(c *gin.Context) {
 func (h *ProxyHandler) SendPromptAsync(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	wid := c.Param("id")
 	if h.isSessionActive(c.Request.Context(), wid, sid) {
 		c.Header("Retry-After", "1")
-		c.JSON(http.StatusConflict, gin.H{
-			"error":      "session is busy; retry after idle",
+		apierrors.RespondDetails(c, http.StatusConflict, "session is busy; retry after idle", map[string]interface{}{
 			"retryAfter": 1,
 		})
 		return
@@ -130,7 +130,7 @@ const upstreamHistoryBodyCap = 16 * 1024 * 1024
 func (h *ProxyHandler) GetHistory(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 
@@ -138,7 +138,7 @@ func (h *ProxyHandler) GetHistory(c *gin.Context) {
 	// malformed ?limit shouldn't waste a connection slot or a k8s API call.
 	limit, err := parseHistoryLimit(c.Query("limit"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	before := c.Query("before")
@@ -161,7 +161,7 @@ func (h *ProxyHandler) GetHistory(c *gin.Context) {
 	if parseErr != nil {
 		h.logger.Error("Failed to parse opencode history", parseErr,
 			"sessionID", sid, "size", len(body))
-		c.JSON(http.StatusBadGateway, gin.H{"error": "malformed upstream history"})
+		apierrors.RespondStatus(c, http.StatusBadGateway, "malformed upstream history")
 		return
 	}
 
@@ -202,7 +202,7 @@ func parseHistoryLimit(raw string) (int, error) {
 func (h *ProxyHandler) fetchUpstreamHistory(c *gin.Context, sessionID string) ([]byte, int, error) {
 	workspaceID := c.Param("id")
 	if workspaceID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "workspace ID required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "workspace ID required")
 		return nil, 0, fmt.Errorf("missing workspace id")
 	}
 
@@ -216,21 +216,20 @@ func (h *ProxyHandler) fetchUpstreamHistory(c *gin.Context, sessionID string) ([
 		v1Client, vErr := h.k8sClient.LlmsafespacesV1()
 		if vErr != nil {
 			h.logger.Error("Failed to get LLMSafespacesV1 client", vErr, "workspaceID", workspaceID)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "internal error")
 			return nil, 0, vErr
 		}
 		var getErr error
 		workspace, getErr = v1Client.Workspaces(h.namespace).Get(c.Request.Context(), workspaceID, metav1.GetOptions{})
 		if getErr != nil {
 			h.logger.Error("Failed to get workspace CRD", getErr, "workspaceID", workspaceID)
-			c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 			return nil, 0, getErr
 		}
 	}
 	if workspace.Status.Phase != phaseActive || workspace.Status.PodIP == "" {
 		c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-		c.JSON(http.StatusServiceUnavailable, gin.H{
-			"error":      "workspace not ready",
+		apierrors.RespondDetails(c, http.StatusServiceUnavailable, "workspace not ready", map[string]interface{}{
 			"phase":      workspace.Status.Phase,
 			"retryAfter": retryAfterSec,
 		})
@@ -240,14 +239,13 @@ func (h *ProxyHandler) fetchUpstreamHistory(c *gin.Context, sessionID string) ([
 	password, err := h.getPassword(c.Request.Context(), workspaceID)
 	if err != nil {
 		h.logger.Error("Failed to get workspace password", err, "workspaceID", workspaceID)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve workspace credentials"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to retrieve workspace credentials")
 		return nil, 0, err
 	}
 
 	if !h.acquireConnection(workspaceID) {
 		c.Header("Retry-After", fmt.Sprintf("%d", retryAfterSec))
-		c.JSON(http.StatusTooManyRequests, gin.H{
-			"error":      "connection limit reached",
+		apierrors.RespondDetails(c, http.StatusTooManyRequests, "connection limit reached", map[string]interface{}{
 			"retryAfter": retryAfterSec,
 		})
 		return nil, 0, fmt.Errorf("connection limit")
@@ -293,14 +291,13 @@ func (h *ProxyHandler) fetchUpstreamHistory(c *gin.Context, sessionID string) ([
 			// distinguish a transient pod-restart from a malformed history
 			// (which surfaces as 502). The 503 is a fast-fail, not a
 			// buffered retry — buffering is reserved for writes.
-			c.JSON(http.StatusServiceUnavailable, gin.H{
-				"error":      "workspace connection failed",
+			apierrors.RespondDetails(c, http.StatusServiceUnavailable, "workspace connection failed", map[string]interface{}{
 				"retryAfter": retryAfterSec,
 			})
 			return nil, 0, doErr
 		}
 		h.logger.Error("History upstream request failed", doErr, "workspaceID", workspaceID)
-		c.JSON(http.StatusBadGateway, gin.H{"error": "upstream request failed"})
+		apierrors.RespondStatus(c, http.StatusBadGateway, "upstream request failed")
 		return nil, 0, doErr
 	}
 
@@ -510,7 +507,7 @@ func messageIsDisplayable(raw json.RawMessage) (string, bool) {
 func (h *ProxyHandler) GetSession(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	h.proxyToWorkspace(c, "/session/"+sid, false, sid)
@@ -519,7 +516,7 @@ func (h *ProxyHandler) GetSession(c *gin.Context) {
 func (h *ProxyHandler) AbortSession(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	wid := c.Param("id")
@@ -646,7 +643,7 @@ func (h *ProxyHandler) flushAndAbortAfterIdle(workspaceID, sessionID string, msg
 func (h *ProxyHandler) DeleteSession(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	workspaceID := c.Param("id")
@@ -801,34 +798,34 @@ type enqueueRequest struct {
 func (h *ProxyHandler) EnqueueMessage(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	wid := c.Param("id")
 
 	var req enqueueRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 	if len(req.Text) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "text must not be empty"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "text must not be empty")
 		return
 	}
 	if len(req.Text) > 100_000 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "text exceeds 100KB limit"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "text exceeds 100KB limit")
 		return
 	}
 
 	if h.queueSvc == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "message queue not available"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "message queue not available")
 		return
 	}
 
 	msgID, err := h.queueSvc.Enqueue(c.Request.Context(), wid, sid, req.Text)
 	if err != nil {
 		h.logger.Error("Failed to enqueue message", err, "workspaceID", wid, "sessionID", sid)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to enqueue message"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to enqueue message")
 		return
 	}
 
@@ -858,7 +855,7 @@ func (h *ProxyHandler) EnqueueMessage(c *gin.Context) {
 func (h *ProxyHandler) ListQueue(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	wid := c.Param("id")
@@ -871,7 +868,7 @@ func (h *ProxyHandler) ListQueue(c *gin.Context) {
 	msgs, err := h.queueSvc.PeekAll(c.Request.Context(), wid, sid)
 	if err != nil {
 		h.logger.Error("Failed to list queue", err, "workspaceID", wid, "sessionID", sid)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list queue"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list queue")
 		return
 	}
 
@@ -881,13 +878,13 @@ func (h *ProxyHandler) ListQueue(c *gin.Context) {
 func (h *ProxyHandler) DeleteQueueMessage(c *gin.Context) {
 	sid := c.Param("sessionId")
 	if err := validateSessionID(sid); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sessionId: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid sessionId: "+err.Error())
 		return
 	}
 	wid := c.Param("id")
 	msgID := c.Param("messageId")
 	if msgID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "messageId required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "messageId required")
 		return
 	}
 
@@ -898,7 +895,7 @@ func (h *ProxyHandler) DeleteQueueMessage(c *gin.Context) {
 
 	if err := h.queueSvc.Remove(c.Request.Context(), wid, sid, msgID); err != nil {
 		h.logger.Error("Failed to remove queue message", err, "workspaceID", wid, "sessionID", sid, "messageID", msgID)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to remove message"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to remove message")
 		return
 	}
 
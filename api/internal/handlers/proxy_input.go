@@ -15,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	apitypes "github.com/lenaxia/llmsafespaces/api/internal/types"
 	"github.com/lenaxia/llmsafespaces/pkg/agent"
 )
@@ -27,7 +28,7 @@ var (
 // ListQuestions proxies GET /question to the workspace pod.
 func (h *ProxyHandler) ListQuestions(c *gin.Context) {
 	if h.dialect == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "dialect not configured"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "dialect not configured")
 		return
 	}
 	h.proxyToWorkspace(c, h.dialect.QuestionListPath(), false, "")
@@ -36,12 +37,12 @@ func (h *ProxyHandler) ListQuestions(c *gin.Context) {
 // QuestionReply proxies POST /question/:requestID/reply to the workspace pod.
 func (h *ProxyHandler) QuestionReply(c *gin.Context) {
 	if h.dialect == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "dialect not configured"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "dialect not configured")
 		return
 	}
 	requestID := c.Param("requestID")
 	if !questionIDPattern.MatchString(requestID) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question request ID format"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid question request ID format")
 		return
 	}
 	h.proxyToWorkspace(c, h.dialect.QuestionReplyPath(requestID), false, "")
@@ -50,12 +51,12 @@ func (h *ProxyHandler) QuestionReply(c *gin.Context) {
 // QuestionReject proxies POST /question/:requestID/reject to the workspace pod.
 func (h *ProxyHandler) QuestionReject(c *gin.Context) {
 	if h.dialect == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "dialect not configured"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "dialect not configured")
 		return
 	}
 	requestID := c.Param("requestID")
 	if !questionIDPattern.MatchString(requestID) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid question request ID format"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid question request ID format")
 		return
 	}
 	h.proxyToWorkspace(c, h.dialect.QuestionRejectPath(requestID), false, "")
@@ -64,7 +65,7 @@ func (h *ProxyHandler) QuestionReject(c *gin.Context) {
 // ListPermissions proxies GET /permission to the workspace pod.
 func (h *ProxyHandler) ListPermissions(c *gin.Context) {
 	if h.dialect == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "dialect not configured"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "dialect not configured")
 		return
 	}
 	h.proxyToWorkspace(c, h.dialect.PermissionListPath(), false, "")
@@ -73,12 +74,12 @@ func (h *ProxyHandler) ListPermissions(c *gin.Context) {
 // PermissionReply proxies POST /permission/:requestID/reply to the workspace pod.
 func (h *ProxyHandler) PermissionReply(c *gin.Context) {
 	if h.dialect == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "dialect not configured"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "dialect not configured")
 		return
 	}
 	requestID := c.Param("requestID")
 	if !permissionIDPattern.MatchString(requestID) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid permission request ID format"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid permission request ID format")
 		return
 	}
 	h.proxyToWorkspace(c, h.dialect.PermissionReplyPath(requestID), false, "")
@@ -50,6 +50,7 @@ func (h *ProxyHandler) Start() error {
 			return
 		}
 		watcher.SetUserBroker(h.userBroker)
+		watcher.SetUsageHistory(h.usageHistory)
 		if h.versionSyncCb != nil {
 			watcher.SetVersionSyncCallback(h.versionSyncCb)
 		}
@@ -101,6 +102,12 @@ func (h *ProxyHandler) SetAgentStateChecker(c AgentStateChecker) {
 	h.agentStateChecker = c
 }
 
+// SetUsageHistory sets the store behind GET /workspaces/:id/metrics. Must
+// be called before Start so the CRD watcher feeds it.
+func (h *ProxyHandler) SetUsageHistory(uh *workspace.UsageHistory) {
+	h.usageHistory = uh
+}
+
 func (h *ProxyHandler) SetVersionSyncCallback(cb workspace.VersionSyncCallback) {
 	h.versionSyncCb = cb
 }
@@ -0,0 +1,31 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// GetWorkspaceMetrics handles GET /workspaces/:id/metrics: the workspace's
+// recent CPU and memory samples, oldest first, for a small usage graph.
+// Ownership is enforced by WorkspaceAccessMiddleware on the route group.
+// The series is empty until the agent has been polled twice; it is kept
+// in the shared cache, so every replica returns the same samples.
+func (h *ProxyHandler) GetWorkspaceMetrics(c *gin.Context) {
+	wid := c.Param("id")
+	samples := []types.WorkspaceUsageSample{}
+	if h.usageHistory != nil {
+		var err error
+		if samples, err = h.usageHistory.Samples(c.Request.Context(), wid); err != nil {
+			apierrors.Respond(c, apierrors.NewInternalError("failed to read usage history", err))
+			return
+		}
+	}
+	c.JSON(http.StatusOK, types.WorkspaceUsageHistory{WorkspaceID: wid, Samples: samples})
+}
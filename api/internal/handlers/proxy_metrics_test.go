@@ -0,0 +1,112 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/lenaxia/llmsafespaces/api/internal/config"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/api/internal/logger"
+	"github.com/lenaxia/llmsafespaces/api/internal/services/cache"
+	"github.com/lenaxia/llmsafespaces/api/internal/services/workspace"
+	k8smocks "github.com/lenaxia/llmsafespaces/mocks/kubernetes"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// newMetricsProxyHandler returns a ProxyHandler whose usage history is
+// kept in an in-process Redis, and that Redis so tests can stop it.
+func newMetricsProxyHandler(t *testing.T) (*ProxyHandler, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	port, err := strconv.Atoi(mr.Port())
+	require.NoError(t, err)
+	cfg := &config.Config{}
+	cfg.Redis.Host, cfg.Redis.Port = mr.Host(), port
+	log, err := logger.New(true, "debug", "console")
+	require.NoError(t, err)
+	cacheSvc, err := cache.New(cfg, log)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cacheSvc.Stop() })
+
+	h, err := NewProxyHandler(k8smocks.NewMockKubernetesClient(), &testLogger{}, "default", nil, nil)
+	require.NoError(t, err)
+	h.SetUsageHistory(workspace.NewUsageHistory(cacheSvc, 0))
+	return h, mr
+}
+
+func serveWorkspaceMetrics(h *ProxyHandler, workspaceID string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/workspaces/:id/metrics", h.GetWorkspaceMetrics)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/"+workspaceID+"/metrics", nil))
+	return w
+}
+
+func getWorkspaceMetrics(t *testing.T, h *ProxyHandler, workspaceID string) types.WorkspaceUsageHistory {
+	t.Helper()
+	w := serveWorkspaceMetrics(h, workspaceID)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body types.WorkspaceUsageHistory
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body
+}
+
+func TestGetWorkspaceMetrics_ReturnsRecordedSamples(t *testing.T) {
+	h, _ := newMetricsProxyHandler(t)
+
+	t0 := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i, micros := range []int64{1_000_000, 4_000_000} {
+		checked := metav1.NewTime(t0.Add(time.Duration(i) * 15 * time.Second))
+		require.NoError(t, h.usageHistory.Record(context.Background(), &v1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws-1"},
+			Status: v1.WorkspaceStatus{
+				LastHealthCheckAt: &checked, CpuUsageMicros: micros, MemoryUsedBytes: 512 << 20,
+			},
+		}))
+	}
+
+	body := getWorkspaceMetrics(t, h, "ws-1")
+	assert.Equal(t, "ws-1", body.WorkspaceID)
+	require.Len(t, body.Samples, 1)
+	assert.Equal(t, int64(200), body.Samples[0].CPUMillicores)
+	assert.Equal(t, int64(512<<20), body.Samples[0].MemoryUsedBytes)
+	assert.True(t, body.Samples[0].Timestamp.Equal(t0.Add(15*time.Second)))
+}
+
+func TestGetWorkspaceMetrics_EmptySeriesIsAnArray(t *testing.T) {
+	h, _ := newMetricsProxyHandler(t)
+
+	body := getWorkspaceMetrics(t, h, "ws-unpolled")
+	assert.NotNil(t, body.Samples, "clients graph the array directly; it must not be null")
+	assert.Empty(t, body.Samples)
+}
+
+func TestGetWorkspaceMetrics_CacheFailureIs500(t *testing.T) {
+	h, mr := newMetricsProxyHandler(t)
+	mr.Close()
+
+	w := serveWorkspaceMetrics(h, "ws-1")
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var body apierrors.ErrorBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, apierrors.CodeInternal, body.Code)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/eventbroker"
 	apitypes "github.com/lenaxia/llmsafespaces/api/internal/types"
 )
@@ -20,37 +21,37 @@ import (
 func (h *ProxyHandler) StreamEvents(c *gin.Context) {
 	workspaceID := c.Param("id")
 	if workspaceID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "workspace ID required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "workspace ID required")
 		return
 	}
 
 	v1Client, err := h.k8sClient.LlmsafespacesV1()
 	if err != nil {
 		h.logger.Error("Failed to get LLMSafespacesV1 client for SSE", err, "workspaceID", workspaceID)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "internal error")
 		return
 	}
 	_, err = v1Client.Workspaces(h.namespace).Get(c.Request.Context(), workspaceID, metav1.GetOptions{})
 	if err != nil {
 		h.logger.Error("Failed to get workspace CRD for SSE", err, "workspaceID", workspaceID)
-		c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 		return
 	}
 
 	flusher, ok := c.Writer.(http.Flusher)
 	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "streaming not supported"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "streaming not supported")
 		return
 	}
 
 	if h.userBroker == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "event broker not initialized"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "event broker not initialized")
 		return
 	}
 
 	sub, subErr := h.userBroker.SubscribeWorkspace(workspaceID)
 	if subErr != nil {
-		c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many SSE connections for this workspace"})
+		apierrors.RespondStatus(c, http.StatusTooManyRequests, "too many SSE connections for this workspace")
 		return
 	}
 	defer h.userBroker.UnsubscribeWorkspace(workspaceID, sub)
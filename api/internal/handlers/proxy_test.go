@@ -497,8 +497,8 @@ func TestProxy_ActiveSessionLimit(t *testing.T) {
 
 	var body map[string]interface{}
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
-	assert.Equal(t, float64(2), body["maxActiveSessions"])
-	assert.Equal(t, float64(10), body["retryAfter"])
+	assert.Equal(t, float64(2), body["details"].(map[string]interface{})["maxActiveSessions"])
+	assert.Equal(t, float64(10), body["details"].(map[string]interface{})["retryAfter"])
 	assert.Contains(t, body["error"], "active session limit reached")
 }
 
@@ -2411,7 +2411,7 @@ func TestProxy_SendPromptAsync_Returns409WhenSessionActive(t *testing.T) {
 	var resp map[string]interface{}
 	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 	assert.Equal(t, "session is busy; retry after idle", resp["error"])
-	assert.Equal(t, float64(1), resp["retryAfter"])
+	assert.Equal(t, float64(1), resp["details"].(map[string]interface{})["retryAfter"])
 }
 
 func TestProxy_SendPromptAsync_ProceedsWhenSessionNotActive(t *testing.T) {
@@ -12,10 +12,11 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 	"github.com/lenaxia/llmsafespaces/pkg/interfaces"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -77,11 +78,11 @@ func (h *RelayAdminHandler) GetSetup(c *gin.Context) {
 	resp := setupResponse{}
 
 	if err := h.checkRouter(ctx, &resp); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "router check failed: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "router check failed: "+err.Error())
 		return
 	}
 	if err := h.checkCRD(ctx, &resp); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "CRD check failed: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "CRD check failed: "+err.Error())
 		return
 	}
 	h.checkAWSSecret(ctx, &resp)
@@ -95,7 +96,7 @@ func (h *RelayAdminHandler) GetSetup(c *gin.Context) {
 func (h *RelayAdminHandler) checkRouter(ctx context.Context, resp *setupResponse) error {
 	_, err := h.clientset.AppsV1().Deployments(h.routerNamespace).Get(ctx, "relay-router", metav1.GetOptions{})
 	if err != nil {
-		if apierrors.IsNotFound(err) {
+		if k8serrors.IsNotFound(err) {
 			return nil
 		}
 		return err
@@ -107,7 +108,7 @@ func (h *RelayAdminHandler) checkRouter(ctx context.Context, resp *setupResponse
 func (h *RelayAdminHandler) checkCRD(ctx context.Context, resp *setupResponse) error {
 	resources, err := h.clientset.Discovery().ServerResourcesForGroupVersion("llmsafespaces.dev/v1")
 	if err != nil {
-		if apierrors.IsNotFound(err) || strings.Contains(err.Error(), "empty response") {
+		if k8serrors.IsNotFound(err) || strings.Contains(err.Error(), "empty response") {
 			return nil
 		}
 		return err
@@ -212,7 +213,7 @@ func (h *RelayAdminHandler) GetStatus(c *gin.Context) {
 
 	relays, err := h.llmClient.InferenceRelays().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list InferenceRelays: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list InferenceRelays: "+err.Error())
 		return
 	}
 
@@ -317,7 +318,7 @@ func (h *RelayAdminHandler) SaveOCICreds(c *gin.Context) {
 	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxRelayBodyBytes)
 	var req ociCredsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "tenancy, user, fingerprint, key, and region are required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "tenancy, user, fingerprint, key, and region are required")
 		return
 	}
 
@@ -336,7 +337,7 @@ func (h *RelayAdminHandler) SaveOCICreds(c *gin.Context) {
 	}
 
 	if err := h.upsertSecret(ctx, secret); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save OCI credentials: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to save OCI credentials: "+err.Error())
 		return
 	}
 
@@ -356,7 +357,7 @@ func (h *RelayAdminHandler) SaveGCPCreds(c *gin.Context) {
 	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxRelayBodyBytes)
 	var req gcpCredsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "serviceAccountJson is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "serviceAccountJson is required")
 		return
 	}
 
@@ -371,7 +372,7 @@ func (h *RelayAdminHandler) SaveGCPCreds(c *gin.Context) {
 	}
 
 	if err := h.upsertSecret(ctx, secret); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save GCP credentials: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to save GCP credentials: "+err.Error())
 		return
 	}
 
@@ -393,7 +394,7 @@ func (h *RelayAdminHandler) SaveAWSCreds(c *gin.Context) {
 	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxRelayBodyBytes)
 	var req awsCredsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "accessKeyId, secretAccessKey, and region are required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "accessKeyId, secretAccessKey, and region are required")
 		return
 	}
 
@@ -410,7 +411,7 @@ func (h *RelayAdminHandler) SaveAWSCreds(c *gin.Context) {
 	}
 
 	if err := h.upsertSecret(ctx, secret); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save AWS credentials: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to save AWS credentials: "+err.Error())
 		return
 	}
 
@@ -431,7 +432,7 @@ func (h *RelayAdminHandler) Deploy(c *gin.Context) {
 
 	var req deployRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "providers is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "providers is required")
 		return
 	}
 
@@ -440,7 +441,7 @@ func (h *RelayAdminHandler) Deploy(c *gin.Context) {
 	}
 
 	if len(req.Providers) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "at least one provider is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "at least one provider is required")
 		return
 	}
 
@@ -466,7 +467,7 @@ func (h *RelayAdminHandler) Deploy(c *gin.Context) {
 				CredentialsRef: corev1.LocalObjectReference{Name: "gcp-credentials"},
 			})
 		default:
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown provider: %s (valid: aws, oci, gcp)", p)})
+			apierrors.RespondStatus(c, http.StatusBadRequest, fmt.Sprintf("unknown provider: %s (valid: aws, oci, gcp)", p))
 			return
 		}
 	}
@@ -486,16 +487,16 @@ func (h *RelayAdminHandler) Deploy(c *gin.Context) {
 	}
 
 	existing, err := h.llmClient.InferenceRelays().Get(ctx, "relay-fleet", metav1.GetOptions{})
-	if err != nil && !apierrors.IsNotFound(err) {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check existing relay: " + err.Error()})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check existing relay: "+err.Error())
 		return
 	}
-	// Gate on apierrors.IsNotFound(err), not `existing != nil`. The typed
+	// Gate on k8serrors.IsNotFound(err), not `existing != nil`. The typed
 	// client at pkg/kubernetes/client_crds.go pre-allocates an empty struct
 	// and returns it alongside the NotFound error, so a nil-pointer check is
 	// always false and we would always fall into the Update branch — which
 	// then fails with NotFound on a fresh cluster (worklog 0385).
-	if apierrors.IsNotFound(err) {
+	if k8serrors.IsNotFound(err) {
 		_, err = h.llmClient.InferenceRelays().Create(ctx, relay)
 	} else {
 		relay.ResourceVersion = existing.ResourceVersion
@@ -503,7 +504,7 @@ func (h *RelayAdminHandler) Deploy(c *gin.Context) {
 	}
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to deploy relay fleet: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to deploy relay fleet: "+err.Error())
 		return
 	}
 
@@ -518,16 +519,16 @@ func (h *RelayAdminHandler) Rotate(c *gin.Context) {
 	relayID := c.Param("id")
 
 	if relayID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "relay id is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "relay id is required")
 		return
 	}
 
 	existing, err := h.llmClient.InferenceRelays().Get(ctx, "relay-fleet", metav1.GetOptions{})
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "relay fleet not found"})
+		if k8serrors.IsNotFound(err) {
+			apierrors.RespondStatus(c, http.StatusNotFound, "relay fleet not found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get relay fleet: " + err.Error()})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get relay fleet: "+err.Error())
 		}
 		return
 	}
@@ -535,7 +536,7 @@ func (h *RelayAdminHandler) Rotate(c *gin.Context) {
 	applyAnnotation(existing, "relay.llmsafespaces.dev/rotate", relayID)
 	_, err = h.llmClient.InferenceRelays().Update(ctx, existing)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to trigger rotation: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to trigger rotation: "+err.Error())
 		return
 	}
 
@@ -548,10 +549,10 @@ func (h *RelayAdminHandler) Pause(c *gin.Context) {
 
 	existing, err := h.llmClient.InferenceRelays().Get(ctx, "relay-fleet", metav1.GetOptions{})
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "relay fleet not found"})
+		if k8serrors.IsNotFound(err) {
+			apierrors.RespondStatus(c, http.StatusNotFound, "relay fleet not found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get relay fleet: " + err.Error()})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get relay fleet: "+err.Error())
 		}
 		return
 	}
@@ -559,7 +560,7 @@ func (h *RelayAdminHandler) Pause(c *gin.Context) {
 	applyAnnotation(existing, "relay.llmsafespaces.dev/paused", "true")
 	_, err = h.llmClient.InferenceRelays().Update(ctx, existing)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to pause relay fleet: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to pause relay fleet: "+err.Error())
 		return
 	}
 
@@ -572,10 +573,10 @@ func (h *RelayAdminHandler) Resume(c *gin.Context) {
 
 	existing, err := h.llmClient.InferenceRelays().Get(ctx, "relay-fleet", metav1.GetOptions{})
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "relay fleet not found"})
+		if k8serrors.IsNotFound(err) {
+			apierrors.RespondStatus(c, http.StatusNotFound, "relay fleet not found")
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get relay fleet: " + err.Error()})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get relay fleet: "+err.Error())
 		}
 		return
 	}
@@ -586,7 +587,7 @@ func (h *RelayAdminHandler) Resume(c *gin.Context) {
 
 	_, err = h.llmClient.InferenceRelays().Update(ctx, existing)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resume relay fleet: " + err.Error()})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to resume relay fleet: "+err.Error())
 		return
 	}
 
@@ -605,7 +606,7 @@ func applyAnnotation(relay *v1.InferenceRelay, key, value string) {
 func (h *RelayAdminHandler) upsertSecret(ctx context.Context, desired *corev1.Secret) error {
 	existing, err := h.clientset.CoreV1().Secrets(h.namespace).Get(ctx, desired.Name, metav1.GetOptions{})
 	if err != nil {
-		if !apierrors.IsNotFound(err) {
+		if !k8serrors.IsNotFound(err) {
 			return err
 		}
 		_, err = h.clientset.CoreV1().Secrets(h.namespace).Create(ctx, desired, metav1.CreateOptions{})
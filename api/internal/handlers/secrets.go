@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	pkgerrors "github.com/lenaxia/llmsafespaces/pkg/errors"
 	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
@@ -114,13 +115,13 @@ func (h *SecretsHandler) SetModelCache(c ModelCache) {
 func (h *SecretsHandler) CreateSecret(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	var req secrets.CreateSecretRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -137,13 +138,13 @@ func (h *SecretsHandler) CreateSecret(c *gin.Context) {
 func (h *SecretsHandler) ListSecrets(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	list, err := h.svc.ListSecrets(c.Request.Context(), userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list secrets"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list secrets")
 		return
 	}
 	if list == nil {
@@ -157,7 +158,7 @@ func (h *SecretsHandler) ListSecrets(c *gin.Context) {
 func (h *SecretsHandler) GetSecret(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -175,14 +176,14 @@ func (h *SecretsHandler) GetSecret(c *gin.Context) {
 func (h *SecretsHandler) UpdateSecret(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	secretID := c.Param("id")
 	var req secrets.UpdateSecretRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -198,7 +199,7 @@ func (h *SecretsHandler) UpdateSecret(c *gin.Context) {
 func (h *SecretsHandler) DeleteSecret(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -222,7 +223,7 @@ func (h *SecretsHandler) DeleteSecret(c *gin.Context) {
 func (h *SecretsHandler) RevealSecret(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -231,7 +232,7 @@ func (h *SecretsHandler) RevealSecret(c *gin.Context) {
 		Password string `json:"password" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "password required to reveal secret"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "password required to reveal secret")
 		return
 	}
 
@@ -240,7 +241,7 @@ func (h *SecretsHandler) RevealSecret(c *gin.Context) {
 		// is safer than serving them without verification.
 		h.warn("RevealSecret blocked: no password verifier configured",
 			"userID", userID, "secretID", secretID)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "password verification not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "password verification not configured")
 		return
 	}
 	if err := h.passwordVerifier.VerifyPassword(c.Request.Context(), userID, []byte(req.Password)); err != nil {
@@ -250,7 +251,7 @@ func (h *SecretsHandler) RevealSecret(c *gin.Context) {
 		// bcrypt diagnostic detail; warn-level only.
 		h.warn("RevealSecret password verification failed",
 			"userID", userID, "secretID", secretID)
-		c.JSON(http.StatusForbidden, gin.H{"error": "invalid password"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "invalid password")
 		return
 	}
 
@@ -288,13 +289,13 @@ func (h *SecretsHandler) RevealSecret(c *gin.Context) {
 func (h *SecretsHandler) GetSecretBindings(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	secretID := c.Param("id")
 	workspaces, err := h.svc.GetBindingsForSecret(c.Request.Context(), userID, secretID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get bindings"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get bindings")
 		return
 	}
 	if workspaces == nil {
@@ -306,14 +307,14 @@ func (h *SecretsHandler) GetSecretBindings(c *gin.Context) {
 func (h *SecretsHandler) SetBindings(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	workspaceID := c.Param("id")
 	var req secrets.SetBindingsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
@@ -341,14 +342,14 @@ func (h *SecretsHandler) SetBindings(c *gin.Context) {
 func (h *SecretsHandler) GetBindings(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	workspaceID := c.Param("id")
 	resp, err := h.svc.GetBindings(c.Request.Context(), userID, workspaceID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get bindings"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get bindings")
 		return
 	}
 
@@ -360,7 +361,7 @@ func (h *SecretsHandler) GetBindings(c *gin.Context) {
 func (h *SecretsHandler) ReloadSecrets(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -375,11 +376,11 @@ func (h *SecretsHandler) ReloadSecrets(c *gin.Context) {
 	if err != nil {
 		switch err {
 		case errPodIPResolverNotConfigured:
-			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "secret reload not configured"})
+			apierrors.RespondStatus(c, http.StatusServiceUnavailable, "secret reload not configured")
 		case errNoRunningPod:
-			c.JSON(http.StatusConflict, gin.H{"error": "workspace has no running pod"})
+			apierrors.RespondStatus(c, http.StatusConflict, "workspace has no running pod")
 		default:
-			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusBadGateway, err.Error())
 		}
 		return
 	}
@@ -520,7 +521,7 @@ func (h *SecretsHandler) doReload(ctx context.Context, userID, workspaceID strin
 func (h *SecretsHandler) GetAuditLog(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -533,7 +534,7 @@ func (h *SecretsHandler) GetAuditLog(c *gin.Context) {
 
 	entries, err := h.svc.QueryAudit(c.Request.Context(), userID, query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to query audit log"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to query audit log")
 		return
 	}
 	if entries == nil {
@@ -585,7 +586,7 @@ func (h *RotateKeyHandler) SetAuditFunc(f func(userID, action string)) {
 func (h *RotateKeyHandler) RotateKey(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -593,17 +594,17 @@ func (h *RotateKeyHandler) RotateKey(c *gin.Context) {
 		Password string `json:"password" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "password required for key rotation"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "password required for key rotation")
 		return
 	}
 
 	result, err := h.keySvc.RotateKeyWithPassword(c.Request.Context(), userID, []byte(req.Password), sessionID, 24*time.Hour)
 	if err != nil {
 		if errors.Is(err, secrets.ErrInvalidPassword) {
-			c.JSON(http.StatusForbidden, gin.H{"error": "invalid password"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "invalid password")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "key rotation failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "key rotation failed")
 		return
 	}
 
@@ -621,7 +622,7 @@ func (h *RotateKeyHandler) RotateKey(c *gin.Context) {
 func (h *RotateKeyHandler) ChangePassword(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -630,23 +631,23 @@ func (h *RotateKeyHandler) ChangePassword(c *gin.Context) {
 		NewPassword string `json:"newPassword" binding:"required,min=8"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "oldPassword and newPassword (min 8 chars) required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "oldPassword and newPassword (min 8 chars) required")
 		return
 	}
 
 	if err := h.keySvc.ChangePassword(c.Request.Context(), userID, sessionID, []byte(req.OldPassword), []byte(req.NewPassword)); err != nil {
 		if errors.Is(err, secrets.ErrInvalidPassword) {
-			c.JSON(http.StatusForbidden, gin.H{"error": "invalid current password"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "invalid current password")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "password change failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "password change failed")
 		return
 	}
 
 	// Also update the bcrypt hash in the user database
 	if h.pwUpdater != nil {
 		if err := h.pwUpdater.UpdatePasswordHash(c.Request.Context(), userID, []byte(req.NewPassword)); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "password change failed"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "password change failed")
 			return
 		}
 	}
@@ -664,20 +665,20 @@ func (h *RotateKeyHandler) RecoverAccount(c *gin.Context) {
 		NewPassword string `json:"newPassword" binding:"required,min=8"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "userId, recoveryKey, and newPassword required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "userId, recoveryKey, and newPassword required")
 		return
 	}
 
 	newRecoveryKey, err := h.keySvc.ResetWithRecoveryKey(c.Request.Context(), req.UserID, req.RecoveryKey, []byte(req.NewPassword))
 	if err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"error": "invalid recovery key"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "invalid recovery key")
 		return
 	}
 
 	// Also update the bcrypt hash so the user can login with the new password
 	if h.pwUpdater != nil {
 		if err := h.pwUpdater.UpdatePasswordHash(c.Request.Context(), req.UserID, []byte(req.NewPassword)); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "recovery failed"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "recovery failed")
 			return
 		}
 	}
@@ -740,8 +741,8 @@ func handleSecretError(c *gin.Context, err error) {
 		if se.Status == http.StatusBadRequest {
 			msg = err.Error()
 		}
-		c.JSON(se.StatusCode(), gin.H{"error": msg})
+		apierrors.RespondStatus(c, se.StatusCode(), msg)
 		return
 	}
-	c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+	apierrors.RespondStatus(c, http.StatusInternalServerError, "internal error")
 }
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/settings"
 )
 
@@ -63,22 +64,22 @@ func (h *SettingsHandler) SetAdminSetting(c *gin.Context) {
 		Value json.RawMessage `json:"value" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "value is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "value is required")
 		return
 	}
 
 	var value any
 	if err := json.Unmarshal(body.Value, &value); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid value format"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid value format")
 		return
 	}
 
 	if err := h.instanceSvc.Set(c.Request.Context(), key, value); err != nil {
 		if errors.Is(err, settings.ErrReadOnly) {
-			c.JSON(http.StatusConflict, gin.H{"error": "setting is managed by Helm; edit the Helm values and upgrade"})
+			apierrors.RespondStatus(c, http.StatusConflict, "setting is managed by Helm; edit the Helm values and upgrade")
 			return
 		}
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -89,7 +90,7 @@ func (h *SettingsHandler) SetAdminSetting(c *gin.Context) {
 func (h *SettingsHandler) GetUserSettings(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -124,7 +125,7 @@ func (h *SettingsHandler) GetUserSettingsSchema(c *gin.Context) {
 func (h *SettingsHandler) SetUserSetting(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -134,18 +135,18 @@ func (h *SettingsHandler) SetUserSetting(c *gin.Context) {
 		Value json.RawMessage `json:"value" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "value is required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "value is required")
 		return
 	}
 
 	var value any
 	if err := json.Unmarshal(body.Value, &value); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid value format"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid value format")
 		return
 	}
 
 	if err := h.userSvc.Set(c.Request.Context(), userID, key, value); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/eventbroker"
 	apitypes "github.com/lenaxia/llmsafespaces/api/internal/types"
 )
@@ -44,31 +45,31 @@ var (
 // It delivers workspace.phase events for ALL of the user's workspaces.
 func (h *ProxyHandler) StreamUserEvents(c *gin.Context) {
 	if !sseConnAllowed(c.ClientIP()) {
-		c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many SSE connection attempts"})
+		apierrors.RespondStatus(c, http.StatusTooManyRequests, "too many SSE connection attempts")
 		return
 	}
 
 	userID, _ := c.Get("userID")
 	uid, ok := userID.(string)
 	if !ok || uid == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	if h.userBroker == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "event broker not initialized"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "event broker not initialized")
 		return
 	}
 
 	flusher, ok := c.Writer.(http.Flusher)
 	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "streaming not supported"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "streaming not supported")
 		return
 	}
 
 	s, err := h.userBroker.SubscribeUser(uid)
 	if err != nil {
-		c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many connections"})
+		apierrors.RespondStatus(c, http.StatusTooManyRequests, "too many connections")
 		return
 	}
 	defer h.userBroker.UnsubscribeUser(uid, s)
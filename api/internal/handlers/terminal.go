@@ -10,12 +10,15 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +37,29 @@ const (
 	defaultMaxPerWS    = 5
 	defaultMaxGlobal   = 500
 	terminalShell      = "/bin/sh"
+
+	// maxIdleReapInterval bounds how often the idle reaper scans; short
+	// idle timeouts scan at half the timeout instead.
+	maxIdleReapInterval = time.Minute
+	// closeWriteTimeout bounds the close-frame write to an evicted or
+	// reaped client so a stalled peer cannot block the caller.
+	closeWriteTimeout = time.Second
+	// closeReasonServerShutdown is the close-frame reason sent to every
+	// session when the API shuts down, so clients can reconnect to another
+	// replica instead of reporting an error.
+	closeReasonServerShutdown = "server_shutdown"
+	// drainPollInterval is how often CloseAllSessions checks whether
+	// notified sessions have wound down on their own.
+	drainPollInterval = 50 * time.Millisecond
+)
+
+// Terminal eviction policies applied when the global session cap is hit.
+const (
+	// EvictionPolicyReject refuses the new session with 429 (default).
+	EvictionPolicyReject = "reject"
+	// EvictionPolicyLRU closes the least-recently-active session to make
+	// room for the new one.
+	EvictionPolicyLRU = "lru"
 )
 
 // parameterScheme is used to encode PodExecOptions for the exec request.
@@ -90,17 +116,28 @@ type TerminalHandler struct {
 	namespace string
 	logger    pkginterfaces.LoggerInterface
 
-	// Connection tracking
+	// Connection tracking. sessions, wsConns and nextSessionID are
+	// guarded by wsConnsMu; globalConns mirrors len(sessions) plus any
+	// slots reserved before the WebSocket upgrade completes.
 	wsConns              map[string]int
 	wsConnsMu            sync.Mutex
 	globalConns          atomic.Int64
 	maxPerWorkspaceConns int
 	maxGlobalConns       int
+	sessions             map[uint64]*terminalSession
+	nextSessionID        uint64
+	evictionPolicy       string
+	idleTimeout          time.Duration
+	shuttingDown         bool
 
 	// K8s exec (nil in tests)
 	restConfig *rest.Config
 	clientset  kubernetes.Interface
 
+	// allowedOrigins lists the cross-origin pages allowed to open a
+	// terminal; same-origin upgrades are always allowed. See checkOrigin.
+	allowedOrigins []string
+
 	upgrader websocket.Upgrader
 }
 
@@ -111,7 +148,7 @@ func NewTerminalHandler(
 	namespace string,
 	logger pkginterfaces.LoggerInterface,
 ) *TerminalHandler {
-	return &TerminalHandler{
+	h := &TerminalHandler{
 		cache:                cache,
 		wsGetter:             wsGetter,
 		namespace:            namespace,
@@ -119,10 +156,12 @@ func NewTerminalHandler(
 		wsConns:              make(map[string]int),
 		maxPerWorkspaceConns: defaultMaxPerWS,
 		maxGlobalConns:       defaultMaxGlobal,
-		upgrader: websocket.Upgrader{
-			CheckOrigin: func(r *http.Request) bool { return true },
-		},
+		sessions:             make(map[uint64]*terminalSession),
+		evictionPolicy:       EvictionPolicyReject,
+		idleTimeout:          defaultIdleTimeout,
 	}
+	h.upgrader.CheckOrigin = h.originAllowed
+	return h
 }
 
 // SetExecConfig sets the K8s config for pod exec (call after construction).
@@ -131,11 +170,81 @@ func (h *TerminalHandler) SetExecConfig(cfg *rest.Config, cs kubernetes.Interfac
 	h.clientset = cs
 }
 
+// SetAllowedOrigins sets the origins, besides the API's own, whose pages
+// may open a terminal WebSocket (security.allowedOrigins, shared with
+// CORS). "*" allows any origin and is meant for development only.
+func (h *TerminalHandler) SetAllowedOrigins(origins []string) {
+	h.allowedOrigins = h.allowedOrigins[:0]
+	for _, o := range origins {
+		if o = strings.TrimSpace(o); o != "" {
+			h.allowedOrigins = append(h.allowedOrigins, o)
+		}
+	}
+}
+
+// checkOrigin guards the upgrade against cross-site WebSocket hijacking:
+// a browser page on another site must not be able to drive a terminal
+// with a ticket it obtained. Same-origin pages (Origin host matches the
+// request Host, the default single-Ingress deployment) and origins on the
+// allowlist pass. A missing Origin is allowed with a warning — it comes
+// from non-browser clients such as the CLI, which the single-use ticket
+// already authenticates. HandleTerminal calls this once per upgrade; the
+// upgrader re-checks with originAllowed so the warning is not repeated.
+func (h *TerminalHandler) checkOrigin(r *http.Request) bool {
+	if r.Header.Get("Origin") == "" && h.logger != nil {
+		h.logger.Warn("Terminal WebSocket upgrade without Origin header",
+			"path", r.URL.Path, "remote_addr", r.RemoteAddr)
+	}
+	return h.originAllowed(r)
+}
+
+// originAllowed is checkOrigin without the logging.
+func (h *TerminalHandler) originAllowed(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && u.Host != "" && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range h.allowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSessionLimits configures the global session cap, the policy applied
+// when it is reached, and the idle timeout used by RunIdleReaper. Zero or
+// empty values keep the defaults; an unknown policy falls back to reject.
+func (h *TerminalHandler) SetSessionLimits(maxSessions int, idleTimeout time.Duration, policy string) {
+	h.wsConnsMu.Lock()
+	defer h.wsConnsMu.Unlock()
+	if maxSessions > 0 {
+		h.maxGlobalConns = maxSessions
+	}
+	if idleTimeout > 0 {
+		h.idleTimeout = idleTimeout
+	}
+	switch policy {
+	case EvictionPolicyLRU:
+		h.evictionPolicy = EvictionPolicyLRU
+	case "", EvictionPolicyReject:
+		h.evictionPolicy = EvictionPolicyReject
+	default:
+		h.evictionPolicy = EvictionPolicyReject
+		if h.logger != nil {
+			h.logger.Warn("Unknown terminal eviction policy; using reject", "policy", policy)
+		}
+	}
+}
+
 // HandleTicket handles POST /workspaces/:id/terminal/ticket.
 func (h *TerminalHandler) HandleTicket(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -143,20 +252,20 @@ func (h *TerminalHandler) HandleTicket(c *gin.Context) {
 
 	ws, err := h.wsGetter.GetWorkspace(c.Request.Context(), workspaceID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 		return
 	}
 
 	// Phase check
 	if ws.Status.Phase != v1.WorkspacePhaseActive || ws.Status.PodName == "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "workspace not active"})
+		apierrors.RespondStatus(c, http.StatusConflict, "workspace not active")
 		return
 	}
 
 	// Generate ticket
 	ticket, err := generateTicket()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate ticket"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to generate ticket")
 		return
 	}
 
@@ -164,7 +273,7 @@ func (h *TerminalHandler) HandleTicket(c *gin.Context) {
 	data, _ := json.Marshal(ticketData{UserID: userID, WorkspaceID: workspaceID})
 	key := ticketKeyPrefix + ticket
 	if err := h.cache.Set(c.Request.Context(), key, string(data), ticketTTL); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store ticket"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to store ticket")
 		return
 	}
 
@@ -180,7 +289,19 @@ func (h *TerminalHandler) HandleTerminal(c *gin.Context) {
 	ticket := c.Query("ticket")
 
 	if ticket == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "ticket required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "ticket required")
+		return
+	}
+
+	// Check the origin before consuming the ticket so a rejected
+	// cross-site attempt does not burn it. The upgrader re-checks
+	// without logging.
+	if !h.checkOrigin(c.Request) {
+		if h.logger != nil {
+			h.logger.Warn("Terminal WebSocket upgrade from disallowed origin",
+				"origin", c.GetHeader("Origin"), "workspaceID", workspaceID)
+		}
+		apierrors.RespondStatus(c, http.StatusForbidden, "origin not allowed")
 		return
 	}
 
@@ -189,7 +310,7 @@ func (h *TerminalHandler) HandleTerminal(c *gin.Context) {
 	key := ticketKeyPrefix + ticket
 	raw, err := h.cache.Get(ctx, key)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired ticket"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "invalid or expired ticket")
 		return
 	}
 	// Delete immediately (single-use)
@@ -197,27 +318,32 @@ func (h *TerminalHandler) HandleTerminal(c *gin.Context) {
 
 	var td ticketData
 	if err := json.Unmarshal([]byte(raw), &td); err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid ticket data"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "invalid ticket data")
 		return
 	}
 
 	// Verify ticket matches workspace
 	if td.WorkspaceID != workspaceID {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "ticket workspace mismatch"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "ticket workspace mismatch")
 		return
 	}
 
 	// Connection limits
-	if !h.acquireConnection(workspaceID) {
-		c.JSON(http.StatusTooManyRequests, gin.H{"error": "terminal connection limit reached"})
+	sess, ok := h.acquireSession(workspaceID)
+	if !ok {
+		if h.isShuttingDown() {
+			apierrors.RespondStatus(c, http.StatusServiceUnavailable, "server shutting down")
+			return
+		}
+		apierrors.RespondStatus(c, http.StatusTooManyRequests, "terminal connection limit reached")
 		return
 	}
-	defer h.releaseConnection(workspaceID)
+	defer h.releaseSession(sess)
 
 	// Resolve pod
 	ws, err := h.wsGetter.GetWorkspace(c.Request.Context(), workspaceID)
 	if err != nil || ws.Status.Phase != v1.WorkspacePhaseActive || ws.Status.PodName == "" {
-		c.JSON(http.StatusConflict, gin.H{"error": "workspace not active"})
+		apierrors.RespondStatus(c, http.StatusConflict, "workspace not active")
 		return
 	}
 
@@ -231,6 +357,10 @@ func (h *TerminalHandler) HandleTerminal(c *gin.Context) {
 	}
 	defer func() { _ = conn.Close() }()
 
+	execCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.attachSession(sess, conn, cancel)
+
 	// If no exec config (test mode), just close
 	if h.restConfig == nil || h.clientset == nil {
 		msg := TerminalMessage{Type: "error", Message: "exec not configured"}
@@ -239,7 +369,7 @@ func (h *TerminalHandler) HandleTerminal(c *gin.Context) {
 		return
 	}
 
-	h.bridgeExec(conn, workspaceID, ws.Status.PodName, ws.Status.PodNamespace)
+	h.bridgeExec(execCtx, sess, conn, workspaceID, ws.Status.PodName, ws.Status.PodNamespace)
 }
 
 // bridgeExec creates a K8s exec session and bridges it to the WebSocket.
@@ -254,7 +384,10 @@ func (h *TerminalHandler) HandleTerminal(c *gin.Context) {
 // webhook) OR a legitimate operator-initiated workload sharing the
 // same namespace label would be reachable from any user's terminal
 // endpoint.
-func (h *TerminalHandler) bridgeExec(conn *websocket.Conn, workspaceID, podName, podNamespace string) {
+//
+// ctx is cancelled when the session is evicted or reaped so the exec
+// stream is torn down alongside the WebSocket.
+func (h *TerminalHandler) bridgeExec(ctx context.Context, sess *terminalSession, conn *websocket.Conn, workspaceID, podName, podNamespace string) {
 	if podNamespace == "" {
 		podNamespace = h.namespace
 	}
@@ -265,7 +398,7 @@ func (h *TerminalHandler) bridgeExec(conn *websocket.Conn, workspaceID, podName,
 	// any future change that grants pods/exec more broadly.
 	if h.clientset != nil {
 		pod, err := h.clientset.CoreV1().Pods(podNamespace).
-			Get(context.Background(), podName, metav1.GetOptions{})
+			Get(ctx, podName, metav1.GetOptions{})
 		if err != nil {
 			msg := TerminalMessage{Type: "error", Message: "pod lookup failed"}
 			data, _ := json.Marshal(msg)
@@ -320,6 +453,7 @@ func (h *TerminalHandler) bridgeExec(conn *websocket.Conn, workspaceID, podName,
 			if err != nil {
 				return
 			}
+			sess.touch()
 			var msg TerminalMessage
 			if err := json.Unmarshal(raw, &msg); err != nil {
 				continue
@@ -337,10 +471,10 @@ func (h *TerminalHandler) bridgeExec(conn *websocket.Conn, workspaceID, podName,
 	}()
 
 	// stdout/stderr → WebSocket writer
-	wsWriter := &wsOutputStream{conn: conn}
+	wsWriter := &wsOutputStream{conn: conn, sess: sess}
 
-	// Run exec (blocks until shell exits)
-	err = exec.StreamWithContext(context.Background(), remotecommand.StreamOptions{
+	// Run exec (blocks until shell exits or the session is closed)
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
 		Stdin:             stdinR,
 		Stdout:            wsWriter,
 		Stderr:            wsWriter,
@@ -358,36 +492,274 @@ func (h *TerminalHandler) bridgeExec(conn *websocket.Conn, workspaceID, podName,
 	_ = conn.WriteMessage(websocket.TextMessage, data)
 }
 
-// acquireConnection attempts to acquire a terminal connection slot.
-func (h *TerminalHandler) acquireConnection(workspaceID string) bool {
-	// Check global limit
-	if h.globalConns.Load() >= int64(h.maxGlobalConns) {
-		return false
+// terminalSession tracks one live terminal connection for eviction and
+// idle reaping. conn and cancel are nil until the WebSocket upgrade
+// completes; lastActive is a UnixNano timestamp updated on traffic in
+// either direction.
+type terminalSession struct {
+	id          uint64
+	workspaceID string
+	conn        *websocket.Conn
+	cancel      context.CancelFunc
+	lastActive  atomic.Int64
+	closeSent   atomic.Bool
+	closeOnce   sync.Once
+}
+
+func (s *terminalSession) touch() {
+	if s != nil {
+		s.lastActive.Store(time.Now().UnixNano())
 	}
+}
+
+// sendClose writes a WebSocket close frame carrying reason, at most once
+// per session. WriteControl is safe to call concurrently with the
+// session's own writers.
+func (s *terminalSession) sendClose(code int, reason string) {
+	if s.conn != nil && s.closeSent.CompareAndSwap(false, true) {
+		_ = s.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(code, reason),
+			time.Now().Add(closeWriteTimeout))
+	}
+}
+
+// close notifies the client with a WebSocket close frame carrying reason
+// (unless one was already sent), then tears down the connection and the
+// exec stream.
+func (s *terminalSession) close(code int, reason string) {
+	s.closeOnce.Do(func() {
+		s.sendClose(code, reason)
+		if s.conn != nil {
+			_ = s.conn.Close()
+		}
+		if s.cancel != nil {
+			s.cancel()
+		}
+	})
+}
 
+// acquireSession reserves a connection slot for workspaceID and registers
+// a session for it. The per-workspace limit always rejects. When the
+// global cap is reached, the reject policy refuses the session and the
+// LRU policy closes the least-recently-active attached session instead.
+func (h *TerminalHandler) acquireSession(workspaceID string) (*terminalSession, bool) {
 	h.wsConnsMu.Lock()
-	defer h.wsConnsMu.Unlock()
 
-	if h.wsConns[workspaceID] >= h.maxPerWorkspaceConns {
-		return false
+	if h.shuttingDown || h.wsConns[workspaceID] >= h.maxPerWorkspaceConns {
+		h.wsConnsMu.Unlock()
+		return nil, false
 	}
 
+	var victim *terminalSession
+	if h.globalConns.Load() >= int64(h.maxGlobalConns) {
+		if h.evictionPolicy == EvictionPolicyLRU {
+			victim = h.leastRecentlyActiveLocked()
+		}
+		if victim == nil {
+			h.wsConnsMu.Unlock()
+			return nil, false
+		}
+		h.untrackLocked(victim)
+	}
+
+	if h.sessions == nil {
+		h.sessions = make(map[uint64]*terminalSession)
+	}
+	h.nextSessionID++
+	sess := &terminalSession{id: h.nextSessionID, workspaceID: workspaceID}
+	sess.touch()
+	h.sessions[sess.id] = sess
 	h.wsConns[workspaceID]++
 	h.globalConns.Add(1)
-	return true
+	h.wsConnsMu.Unlock()
+
+	if victim != nil {
+		if h.logger != nil {
+			h.logger.Info("Evicting least-recently-active terminal session",
+				"workspaceID", victim.workspaceID,
+				"idle", time.Since(time.Unix(0, victim.lastActive.Load())).String())
+		}
+		victim.close(websocket.CloseTryAgainLater, "terminal session evicted: server session limit reached")
+	}
+	return sess, true
 }
 
-// releaseConnection releases a terminal connection slot.
-func (h *TerminalHandler) releaseConnection(workspaceID string) {
+// attachSession records the upgraded connection and the exec cancel func
+// on sess so eviction and reaping can close it. A session that finishes
+// its upgrade after CloseAllSessions started is closed straight away.
+func (h *TerminalHandler) attachSession(sess *terminalSession, conn *websocket.Conn, cancel context.CancelFunc) {
+	h.wsConnsMu.Lock()
+	sess.conn = conn
+	sess.cancel = cancel
+	sess.touch()
+	shuttingDown := h.shuttingDown
+	h.wsConnsMu.Unlock()
+
+	if shuttingDown {
+		sess.close(websocket.CloseGoingAway, closeReasonServerShutdown)
+	}
+}
+
+// releaseSession frees the slot held by sess. It is a no-op when the
+// session was already evicted or reaped, which released the slot then.
+func (h *TerminalHandler) releaseSession(sess *terminalSession) {
 	h.wsConnsMu.Lock()
 	defer h.wsConnsMu.Unlock()
 
-	if h.wsConns[workspaceID] > 0 {
-		h.wsConns[workspaceID]--
+	if _, ok := h.sessions[sess.id]; !ok {
+		return
+	}
+	h.untrackLocked(sess)
+}
+
+// untrackLocked removes sess from the registry and releases its slot.
+// Caller must hold wsConnsMu.
+func (h *TerminalHandler) untrackLocked(sess *terminalSession) {
+	delete(h.sessions, sess.id)
+	if h.wsConns[sess.workspaceID] > 0 {
+		h.wsConns[sess.workspaceID]--
+	}
+	if h.wsConns[sess.workspaceID] == 0 {
+		delete(h.wsConns, sess.workspaceID)
 	}
 	h.globalConns.Add(-1)
 }
 
+// leastRecentlyActiveLocked returns the attached session with the oldest
+// activity, or nil. Sessions still mid-upgrade are never chosen.
+// Caller must hold wsConnsMu.
+func (h *TerminalHandler) leastRecentlyActiveLocked() *terminalSession {
+	var oldest *terminalSession
+	for _, s := range h.sessions {
+		if s.conn == nil {
+			continue
+		}
+		if oldest == nil || s.lastActive.Load() < oldest.lastActive.Load() {
+			oldest = s
+		}
+	}
+	return oldest
+}
+
+// RunIdleReaper closes sessions with no traffic for longer than the idle
+// timeout until ctx is cancelled. Reaped clients receive a close frame.
+func (h *TerminalHandler) RunIdleReaper(ctx context.Context) {
+	h.wsConnsMu.Lock()
+	interval := h.idleTimeout / 2
+	h.wsConnsMu.Unlock()
+	if interval > maxIdleReapInterval {
+		interval = maxIdleReapInterval
+	}
+	if interval <= 0 {
+		interval = maxIdleReapInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.reapIdleSessions(time.Now())
+		}
+	}
+}
+
+// reapIdleSessions closes every attached session idle since before
+// now-idleTimeout and returns how many were closed.
+func (h *TerminalHandler) reapIdleSessions(now time.Time) int {
+	h.wsConnsMu.Lock()
+	cutoff := now.Add(-h.idleTimeout).UnixNano()
+	var stale []*terminalSession
+	for _, s := range h.sessions {
+		if s.conn != nil && s.lastActive.Load() < cutoff {
+			stale = append(stale, s)
+		}
+	}
+	for _, s := range stale {
+		h.untrackLocked(s)
+	}
+	h.wsConnsMu.Unlock()
+
+	for _, s := range stale {
+		if h.logger != nil {
+			h.logger.Info("Reaping idle terminal session", "workspaceID", s.workspaceID)
+		}
+		s.close(websocket.CloseGoingAway, "terminal session closed: idle timeout")
+	}
+	return len(stale)
+}
+
+// CloseAllSessions closes every terminal session and refuses new ones;
+// call it on shutdown, since http.Server.Shutdown neither tracks nor
+// closes hijacked WebSocket connections. Each client receives a close
+// frame with reason server_shutdown. When graceful is set and timeout is
+// positive, sessions first get up to timeout to wind down on their own —
+// the client acknowledges the close, the shell sees EOF on stdin and the
+// exec stream ends — and only those still open afterwards are torn down.
+// It returns the number of sessions that had to be closed forcibly.
+func (h *TerminalHandler) CloseAllSessions(graceful bool, timeout time.Duration) int {
+	h.wsConnsMu.Lock()
+	h.shuttingDown = true
+	open := make([]*terminalSession, 0, len(h.sessions))
+	for _, s := range h.sessions {
+		if s.conn != nil {
+			open = append(open, s)
+		}
+	}
+	h.wsConnsMu.Unlock()
+
+	if graceful && timeout > 0 && len(open) > 0 {
+		for _, s := range open {
+			s.sendClose(websocket.CloseGoingAway, closeReasonServerShutdown)
+		}
+		deadline := time.Now().Add(timeout)
+		for h.countTracked(open) > 0 && time.Now().Before(deadline) {
+			time.Sleep(drainPollInterval)
+		}
+	}
+
+	h.wsConnsMu.Lock()
+	var remaining []*terminalSession
+	for _, s := range open {
+		if _, ok := h.sessions[s.id]; ok {
+			h.untrackLocked(s)
+			remaining = append(remaining, s)
+		}
+	}
+	h.wsConnsMu.Unlock()
+
+	for _, s := range remaining {
+		s.close(websocket.CloseGoingAway, closeReasonServerShutdown)
+	}
+	if h.logger != nil && len(open) > 0 {
+		h.logger.Info("Closed terminal sessions for shutdown",
+			"sessions", len(open), "forced", len(remaining))
+	}
+	return len(remaining)
+}
+
+func (h *TerminalHandler) isShuttingDown() bool {
+	h.wsConnsMu.Lock()
+	defer h.wsConnsMu.Unlock()
+	return h.shuttingDown
+}
+
+// countTracked returns how many of sessions are still registered, i.e.
+// whose handlers have not yet returned.
+func (h *TerminalHandler) countTracked(sessions []*terminalSession) int {
+	h.wsConnsMu.Lock()
+	defer h.wsConnsMu.Unlock()
+	n := 0
+	for _, s := range sessions {
+		if _, ok := h.sessions[s.id]; ok {
+			n++
+		}
+	}
+	return n
+}
+
 // generateTicket creates a cryptographically random ticket.
 func generateTicket() (string, error) {
 	b := make([]byte, 32)
@@ -400,6 +772,7 @@ func generateTicket() (string, error) {
 // wsOutputStream writes exec output to a WebSocket connection.
 type wsOutputStream struct {
 	conn *websocket.Conn
+	sess *terminalSession
 }
 
 func (w *wsOutputStream) Write(p []byte) (int, error) {
@@ -411,6 +784,7 @@ func (w *wsOutputStream) Write(p []byte) (int, error) {
 	if err := w.conn.WriteMessage(websocket.TextMessage, data); err != nil {
 		return 0, err
 	}
+	w.sess.touch()
 	return len(p), nil
 }
 
@@ -6,14 +6,17 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -252,18 +255,26 @@ func TestConnectionLimits_PerWorkspace(t *testing.T) {
 	}
 
 	// Fill up to limit
+	var first *terminalSession
 	for i := 0; i < 5; i++ {
-		assert.True(t, h.acquireConnection("ws-1"))
+		sess, ok := h.acquireSession("ws-1")
+		assert.True(t, ok)
+		if first == nil {
+			first = sess
+		}
 	}
 	// Next should fail
-	assert.False(t, h.acquireConnection("ws-1"))
+	_, ok := h.acquireSession("ws-1")
+	assert.False(t, ok)
 
 	// Different workspace should still work
-	assert.True(t, h.acquireConnection("ws-2"))
+	_, ok = h.acquireSession("ws-2")
+	assert.True(t, ok)
 
 	// Release one from ws-1
-	h.releaseConnection("ws-1")
-	assert.True(t, h.acquireConnection("ws-1"))
+	h.releaseSession(first)
+	_, ok = h.acquireSession("ws-1")
+	assert.True(t, ok)
 }
 
 func TestConnectionLimits_Global(t *testing.T) {
@@ -273,11 +284,341 @@ func TestConnectionLimits_Global(t *testing.T) {
 		maxGlobalConns:       3, // low limit for testing
 	}
 
-	assert.True(t, h.acquireConnection("ws-1"))
-	assert.True(t, h.acquireConnection("ws-2"))
-	assert.True(t, h.acquireConnection("ws-3"))
-	assert.False(t, h.acquireConnection("ws-4")) // global limit hit
+	s1, ok := h.acquireSession("ws-1")
+	assert.True(t, ok)
+	_, ok = h.acquireSession("ws-2")
+	assert.True(t, ok)
+	_, ok = h.acquireSession("ws-3")
+	assert.True(t, ok)
+	_, ok = h.acquireSession("ws-4")
+	assert.False(t, ok) // global limit hit
+
+	h.releaseSession(s1)
+	_, ok = h.acquireSession("ws-4")
+	assert.True(t, ok) // now works
+}
+
+// newTestWSPair returns the server side of a live WebSocket connection
+// and the client dialled against it.
+func newTestWSPair(t *testing.T) (server, client *websocket.Conn) {
+	t.Helper()
+	srvCh := make(chan *websocket.Conn, 1)
+	up := websocket.Upgrader{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := up.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		srvCh <- c
+	}))
+	t.Cleanup(ts.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	select {
+	case server = <-srvCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server side of WebSocket never connected")
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	return server, client
+}
+
+// attachTestSession acquires a session and attaches a live connection to
+// it, returning the client end and a channel closed on exec cancel.
+func attachTestSession(t *testing.T, h *TerminalHandler, workspaceID string) (*terminalSession, *websocket.Conn, <-chan struct{}) {
+	t.Helper()
+	sess, ok := h.acquireSession(workspaceID)
+	require.True(t, ok)
+	server, client := newTestWSPair(t)
+	cancelled := make(chan struct{})
+	var once sync.Once
+	h.attachSession(sess, server, func() { once.Do(func() { close(cancelled) }) })
+	return sess, client, cancelled
+}
+
+// readCloseCode reads from the client until the connection fails and
+// returns the close code the server sent, or -1 for a non-close error.
+func readCloseCode(t *testing.T, client *websocket.Conn) int {
+	t.Helper()
+	_ = client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		_, _, err := client.ReadMessage()
+		if err == nil {
+			continue
+		}
+		var ce *websocket.CloseError
+		if errors.As(err, &ce) {
+			return ce.Code
+		}
+		return -1
+	}
+}
+
+func TestSessionEviction_LRUClosesLeastRecentlyActive(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	h.SetSessionLimits(2, 0, EvictionPolicyLRU)
+
+	old, oldClient, oldCancelled := attachTestSession(t, h, "ws-1")
+	recent, _, recentCancelled := attachTestSession(t, h, "ws-2")
+	old.lastActive.Store(time.Now().Add(-time.Hour).UnixNano())
+	recent.touch()
+
+	sess, ok := h.acquireSession("ws-3")
+	require.True(t, ok, "LRU policy should make room instead of rejecting")
+	require.NotNil(t, sess)
+
+	assert.Equal(t, websocket.CloseTryAgainLater, readCloseCode(t, oldClient),
+		"evicted client must be notified with a close frame")
+	select {
+	case <-oldCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("evicted session's exec stream was not cancelled")
+	}
+	select {
+	case <-recentCancelled:
+		t.Fatal("most recently active session must not be evicted")
+	default:
+	}
+
+	assert.Equal(t, int64(2), h.globalConns.Load())
+	assert.Len(t, h.sessions, 2)
+	assert.NotContains(t, h.sessions, old.id)
+
+	// The evicted handler's deferred release must not double-free.
+	h.releaseSession(old)
+	assert.Equal(t, int64(2), h.globalConns.Load())
+}
+
+func TestSessionEviction_RejectPolicyKeepsExistingSessions(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	h.SetSessionLimits(1, 0, EvictionPolicyReject)
+
+	_, _, cancelled := attachTestSession(t, h, "ws-1")
+
+	_, ok := h.acquireSession("ws-2")
+	assert.False(t, ok)
+	select {
+	case <-cancelled:
+		t.Fatal("reject policy must not close existing sessions")
+	default:
+	}
+}
+
+func TestSessionEviction_SkipsSessionsMidUpgrade(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	h.SetSessionLimits(1, 0, EvictionPolicyLRU)
+
+	// Reserved but never attached: nothing to close, so the cap holds.
+	_, ok := h.acquireSession("ws-1")
+	require.True(t, ok)
+	_, ok = h.acquireSession("ws-2")
+	assert.False(t, ok)
+}
+
+func TestReapIdleSessions_ClosesStaleSessions(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	h.SetSessionLimits(0, 10*time.Minute, "")
+
+	stale, staleClient, staleCancelled := attachTestSession(t, h, "ws-1")
+	fresh, _, freshCancelled := attachTestSession(t, h, "ws-2")
+	stale.lastActive.Store(time.Now().Add(-11 * time.Minute).UnixNano())
+	fresh.touch()
+
+	assert.Equal(t, 1, h.reapIdleSessions(time.Now()))
+
+	assert.Equal(t, websocket.CloseGoingAway, readCloseCode(t, staleClient))
+	select {
+	case <-staleCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("reaped session's exec stream was not cancelled")
+	}
+	select {
+	case <-freshCancelled:
+		t.Fatal("active session must not be reaped")
+	default:
+	}
+	assert.Equal(t, int64(1), h.globalConns.Load())
+	assert.NotContains(t, h.sessions, stale.id)
+	assert.Contains(t, h.sessions, fresh.id)
+}
+
+func TestRunIdleReaper_StopsOnContextCancel(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		h.RunIdleReaper(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunIdleReaper did not return after context cancel")
+	}
+}
+
+func TestSetSessionLimits_UnknownPolicyFallsBackToReject(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	h.SetSessionLimits(0, 0, "fifo")
+	assert.Equal(t, EvictionPolicyReject, h.evictionPolicy)
+	assert.Equal(t, defaultMaxGlobal, h.maxGlobalConns)
+	assert.Equal(t, defaultIdleTimeout, h.idleTimeout)
+}
+
+func TestCloseAllSessions_GracefulDrainNotifiesClients(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+
+	sess, ok := h.acquireSession("ws-1")
+	require.True(t, ok)
+	server, client := newTestWSPair(t)
+	cancelled := make(chan struct{})
+	h.attachSession(sess, server, func() { close(cancelled) })
+
+	// Stand in for HandleTerminal: the read loop ends once the client
+	// answers the close frame, and the handler releases its session.
+	go func() {
+		for {
+			if _, _, err := server.ReadMessage(); err != nil {
+				h.releaseSession(sess)
+				return
+			}
+		}
+	}()
+	closeErr := make(chan *websocket.CloseError, 1)
+	go func() {
+		for {
+			_, _, err := client.ReadMessage()
+			if err != nil {
+				var ce *websocket.CloseError
+				errors.As(err, &ce)
+				closeErr <- ce
+				return
+			}
+		}
+	}()
+
+	assert.Equal(t, 0, h.CloseAllSessions(true, 5*time.Second),
+		"a session that winds down within the grace period is not force-closed")
+
+	select {
+	case ce := <-closeErr:
+		require.NotNil(t, ce)
+		assert.Equal(t, websocket.CloseGoingAway, ce.Code)
+		assert.Equal(t, closeReasonServerShutdown, ce.Text)
+	case <-time.After(5 * time.Second):
+		t.Fatal("client never received the shutdown close frame")
+	}
+	select {
+	case <-cancelled:
+		t.Fatal("a session that closed on its own must not be cancelled")
+	default:
+	}
+	assert.Empty(t, h.sessions)
+	assert.Equal(t, int64(0), h.globalConns.Load())
+}
+
+func TestCloseAllSessions_ForcesUnresponsiveSessionsAfterTimeout(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+
+	// Nothing reads on either side, so the session never winds down.
+	sess, client, cancelled := attachTestSession(t, h, "ws-1")
+
+	start := time.Now()
+	assert.Equal(t, 1, h.CloseAllSessions(true, 100*time.Millisecond))
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("force-closed session's exec stream was not cancelled")
+	}
+	assert.Equal(t, websocket.CloseGoingAway, readCloseCode(t, client))
+	assert.Empty(t, h.sessions)
+
+	// The handler's deferred release must not double-free.
+	h.releaseSession(sess)
+	assert.Equal(t, int64(0), h.globalConns.Load())
+}
+
+func TestCloseAllSessions_RefusesNewSessions(t *testing.T) {
+	cache := newMockTerminalCache()
+	_ = cache.Set(context.Background(), "terminal:ticket:tkt_abc123",
+		`{"userID":"user-1","workspaceID":"ws-1"}`, 30*time.Second)
+	h := NewTerminalHandler(cache, &mockWorkspaceGetter{}, "llmsafespaces", nil)
+
+	assert.Equal(t, 0, h.CloseAllSessions(false, 0))
+
+	w := httptest.NewRecorder()
+	setupTerminalRouter(h).ServeHTTP(w,
+		httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/ws-1/terminal?ticket=tkt_abc123", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Empty(t, h.sessions)
+}
+
+func TestCheckOrigin(t *testing.T) {
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", nil)
+	h.SetAllowedOrigins([]string{"https://app.example.com", " https://admin.example.com"})
+
+	cases := []struct {
+		name   string
+		origin string
+		want   bool
+	}{
+		{"no origin (non-browser client)", "", true},
+		{"same origin", "https://api.example.com", true},
+		{"allowlisted", "https://app.example.com", true},
+		{"allowlisted after trimming", "https://admin.example.com", true},
+		{"cross-site", "https://evil.example.net", false},
+		{"scheme mismatch with allowlist", "http://app.example.com", false},
+		{"malformed", "://", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/v1/workspaces/ws-1/terminal", nil)
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+			assert.Equal(t, tc.want, h.checkOrigin(r))
+		})
+	}
+
+	h.SetAllowedOrigins([]string{"*"})
+	r := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/v1/workspaces/ws-1/terminal", nil)
+	r.Header.Set("Origin", "http://localhost:5173")
+	assert.True(t, h.checkOrigin(r), "wildcard allows any origin")
+}
+
+// TestCheckOrigin_MissingOriginWarnsOnce checks that an upgrade without
+// an Origin header is logged by the pre-ticket check only; the upgrader's
+// re-check must not log it a second time.
+func TestCheckOrigin_MissingOriginWarnsOnce(t *testing.T) {
+	logger := &recordingLogger{}
+	h := NewTerminalHandler(newMockTerminalCache(), &mockWorkspaceGetter{}, "llmsafespaces", logger)
+	r := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/v1/workspaces/ws-1/terminal", nil)
+
+	require.True(t, h.checkOrigin(r))
+	require.True(t, h.upgrader.CheckOrigin(r))
+
+	assert.Equal(t, 1, logger.warnCount())
+}
+
+func TestHandleTerminal_DisallowedOriginKeepsTicket(t *testing.T) {
+	cache := newMockTerminalCache()
+	_ = cache.Set(context.Background(), "terminal:ticket:tkt_abc123",
+		`{"userID":"user-1","workspaceID":"ws-1"}`, 30*time.Second)
+	h := NewTerminalHandler(cache, &mockWorkspaceGetter{}, "llmsafespaces", nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/ws-1/terminal?ticket=tkt_abc123", nil)
+	req.Header.Set("Origin", "https://evil.example.net")
+	w := httptest.NewRecorder()
+	setupTerminalRouter(h).ServeHTTP(w, req)
 
-	h.releaseConnection("ws-1")
-	assert.True(t, h.acquireConnection("ws-4")) // now works
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, cache.store, "terminal:ticket:tkt_abc123",
+		"a rejected cross-site attempt must not consume the ticket")
+	assert.Empty(t, h.sessions)
 }
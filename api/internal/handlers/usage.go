@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/api/internal/interfaces"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -35,20 +36,20 @@ func (h *UsageHandler) SetDB(db *sql.DB) {
 func (h *UsageHandler) GetUsage(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	from, to, err := parsePeriod(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	owner := types.BillingOwner{ID: userID, Type: types.OwnerTypeUser}
 	report, err := h.meteringSvc.GetUsage(c.Request.Context(), owner, from, to)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get usage"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get usage")
 		return
 	}
 	c.JSON(http.StatusOK, report)
@@ -58,26 +59,26 @@ func (h *UsageHandler) GetWorkspaceUsage(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	workspaceID := c.Param("id")
 	if userID == "" || workspaceID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "missing user or workspace"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "missing user or workspace")
 		return
 	}
 
 	owned, err := h.dbSvc.CheckResourceOwnership(c.Request.Context(), userID, "workspace", workspaceID)
 	if err != nil || !owned {
-		c.JSON(http.StatusForbidden, gin.H{"error": "access denied"})
+		apierrors.RespondStatus(c, http.StatusForbidden, "access denied")
 		return
 	}
 
 	from, to, err := parsePeriod(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	owner := types.BillingOwner{ID: userID, Type: types.OwnerTypeUser}
 	report, err := h.meteringSvc.GetUsageByWorkspace(c.Request.Context(), owner, workspaceID, from, to)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get usage"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get usage")
 		return
 	}
 	c.JSON(http.StatusOK, report)
@@ -86,14 +87,14 @@ func (h *UsageHandler) GetWorkspaceUsage(c *gin.Context) {
 func (h *UsageHandler) GetQuotaStatus(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	owner := types.BillingOwner{ID: userID, Type: types.OwnerTypeUser}
 	statuses, err := h.meteringSvc.GetQuotaStatus(c.Request.Context(), owner)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get quota status"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get quota status")
 		return
 	}
 	if statuses == nil {
@@ -105,20 +106,20 @@ func (h *UsageHandler) GetQuotaStatus(c *gin.Context) {
 func (h *UsageHandler) AdminGetUsage(c *gin.Context) {
 	ownerID := c.Param("ownerId")
 	if ownerID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "missing owner ID"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "missing owner ID")
 		return
 	}
 
 	from, to, err := parsePeriod(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	owner := types.BillingOwner{ID: ownerID, Type: types.OwnerTypeUser}
 	report, err := h.meteringSvc.GetUsage(c.Request.Context(), owner, from, to)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get usage"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get usage")
 		return
 	}
 	c.JSON(http.StatusOK, report)
@@ -134,7 +135,7 @@ func (h *UsageHandler) AdminGetDLQ(c *gin.Context) {
 		`SELECT id, payload, error_message, retry_count, first_failed_at, last_retried_at
 		 FROM usage_events_dlq WHERE resolved_at IS NULL ORDER BY id DESC LIMIT 100`)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to query DLQ"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to query DLQ")
 		return
 	}
 	defer func() { _ = rows.Close() }()
@@ -157,7 +158,7 @@ func (h *UsageHandler) AdminGetDLQ(c *gin.Context) {
 		entries = append(entries, e)
 	}
 	if err := rows.Err(); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read DLQ entries"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to read DLQ entries")
 		return
 	}
 	if entries == nil {
@@ -177,24 +178,24 @@ func (h *UsageHandler) AdminRetryDLQ(c *gin.Context) {
 	err := h.db.QueryRowContext(c.Request.Context(),
 		`SELECT payload FROM usage_events_dlq WHERE id = $1 AND resolved_at IS NULL`, id).Scan(&payload)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "DLQ entry not found or already resolved"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "DLQ entry not found or already resolved")
 		return
 	}
 
 	var event types.UsageEvent
 	if err := json.Unmarshal(payload, &event); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to unmarshal event"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to unmarshal event")
 		return
 	}
 
 	metaBytes, merr := marshalJSON(event.Metadata)
 	if merr != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to marshal event metadata"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to marshal event metadata")
 		return
 	}
 	rcBytes, rerr := marshalJSON(event.RequestContext)
 	if rerr != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to marshal request context"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to marshal request context")
 		return
 	}
 	_, err = h.db.ExecContext(c.Request.Context(),
@@ -209,13 +210,13 @@ func (h *UsageHandler) AdminRetryDLQ(c *gin.Context) {
 		event.EventTime,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retry event"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to retry event")
 		return
 	}
 
 	if _, err := h.db.ExecContext(c.Request.Context(),
 		`UPDATE usage_events_dlq SET resolved_at=NOW(), resolution='reprocessed' WHERE id=$1`, id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to mark DLQ entry as resolved"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to mark DLQ entry as resolved")
 		return
 	}
 
@@ -232,7 +233,7 @@ func (h *UsageHandler) AdminDiscardDLQ(c *gin.Context) {
 	_, err := h.db.ExecContext(c.Request.Context(),
 		`UPDATE usage_events_dlq SET resolved_at=NOW(), resolution='discarded' WHERE id=$1 AND resolved_at IS NULL`, id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to discard DLQ entry"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to discard DLQ entry")
 		return
 	}
 
@@ -241,7 +242,7 @@ func (h *UsageHandler) AdminDiscardDLQ(c *gin.Context) {
 		`INSERT INTO audit_log (actor_id, domain, action, target_id, metadata)
 		 VALUES ($1, 'billing', 'dlq_discarded', $2, $3)`,
 		actorID, id, `{"source":"admin"}`); aerr != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to write audit log"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to write audit log")
 		return
 	}
 
@@ -257,7 +258,7 @@ func (h *UsageHandler) AdminBillingStatus(c *gin.Context) {
 	var dlqSize int
 	if err := h.db.QueryRowContext(c.Request.Context(),
 		`SELECT COUNT(*) FROM usage_events_dlq WHERE resolved_at IS NULL`).Scan(&dlqSize); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to query DLQ size"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to query DLQ size")
 		return
 	}
 
@@ -280,7 +281,7 @@ func (h *UsageHandler) AdminBillingStatus(c *gin.Context) {
 		cursors[provider] = gin.H{"last_exported_id": lastID, "last_exported_at": lastAt}
 	}
 	if err := rows.Err(); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read cursors"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to read cursors")
 		return
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
 )
 
@@ -74,22 +75,22 @@ func (h *UserProviderCredentialsHandler) SetCredentialStateWriter(w CredentialSt
 func (h *UserProviderCredentialsHandler) Create(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" || sessionID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	var req createAdminCredentialRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if strings.TrimSpace(req.Kind) == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must not be empty"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "kind must not be empty")
 		return
 	}
 	if strings.TrimSpace(req.Slug) == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "slug must not be empty"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "slug must not be empty")
 		return
 	}
 	req.Kind = strings.TrimSpace(req.Kind)
@@ -100,29 +101,33 @@ func (h *UserProviderCredentialsHandler) Create(c *gin.Context) {
 	// with a field-specific message rather than letting the DB CHECK
 	// fire as opaque 500.
 	if err := secrets.ValidateKind(req.Kind); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "kind"})
+		apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+			"field": "kind",
+		})
 		return
 	}
 	if err := secrets.ValidateSlug(req.Slug); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "slug"})
+		apierrors.RespondDetails(c, http.StatusBadRequest, err.Error(), map[string]interface{}{
+			"field": "slug",
+		})
 		return
 	}
 
 	dek, err := h.keys.GetDEK(c.Request.Context(), sessionID, extractMatchedSigningKey(c))
 	if err != nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "encryption unavailable"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "encryption unavailable")
 		return
 	}
 
 	ciphertext, err := encryptCredentialData(c.Request.Context(), func(_ context.Context, pt []byte) ([]byte, error) { return secrets.EncryptSecret(dek, pt) }, req.Kind, req.Slug, req.APIKey, req.BaseURL)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to encode credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to encode credential")
 		return
 	}
 
 	record, err := h.keyStore.GetUserKey(c.Request.Context(), userID)
 	if err != nil || record == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "user keys not available"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "user keys not available")
 		return
 	}
 
@@ -154,14 +159,14 @@ func (h *UserProviderCredentialsHandler) Create(c *gin.Context) {
 	if err := h.store.CreateCredential(c.Request.Context(), "user", userID, row); err != nil {
 		classified := ClassifyPostgresError(err)
 		if errors.Is(classified, ErrDuplicateCredential) {
-			c.JSON(http.StatusConflict, gin.H{"error": "credential with this slug already exists"})
+			apierrors.RespondStatus(c, http.StatusConflict, "credential with this slug already exists")
 			return
 		}
 		if errors.Is(classified, ErrCredentialCheckViolation) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "credential failed validation; kind or slug is invalid"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "credential failed validation; kind or slug is invalid")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to store credential")
 		return
 	}
 
@@ -195,12 +200,12 @@ func (h *UserProviderCredentialsHandler) Create(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) List(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	rows, err := h.store.ListCredentials(c.Request.Context(), "user", userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list credentials"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list credentials")
 		return
 	}
 	resp := make([]CredentialResponse, 0, len(rows))
@@ -234,16 +239,16 @@ func (h *UserProviderCredentialsHandler) List(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) Get(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	row, err := h.store.GetCredential(c.Request.Context(), "user", userID, c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get credential")
 		return
 	}
 	if row == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 	c.JSON(http.StatusOK, CredentialResponse{
@@ -265,7 +270,7 @@ func (h *UserProviderCredentialsHandler) Get(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) Delete(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	credID := c.Param("id")
@@ -284,7 +289,7 @@ func (h *UserProviderCredentialsHandler) Delete(c *gin.Context) {
 			c.Status(http.StatusNoContent)
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete credential")
 		return
 	}
 
@@ -303,7 +308,7 @@ func (h *UserProviderCredentialsHandler) Delete(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) Bind(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	credID := c.Param("id")
@@ -311,23 +316,23 @@ func (h *UserProviderCredentialsHandler) Bind(c *gin.Context) {
 
 	cred, err := h.store.GetCredential(c.Request.Context(), "user", userID, credID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify credential")
 		return
 	}
 	if cred == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 
 	if h.wsOwnerCheck != nil {
 		if err := h.wsOwnerCheck(c.Request.Context(), userID, wsID); err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 			return
 		}
 	}
 
 	if err := h.bindings.BindCredentialToWorkspace(c.Request.Context(), credID, wsID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to bind credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to bind credential")
 		return
 	}
 
@@ -343,7 +348,7 @@ func (h *UserProviderCredentialsHandler) Bind(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) Unbind(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	credID := c.Param("id")
@@ -351,27 +356,27 @@ func (h *UserProviderCredentialsHandler) Unbind(c *gin.Context) {
 
 	cred, err := h.store.GetCredential(c.Request.Context(), "user", userID, credID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify credential")
 		return
 	}
 	if cred == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 
 	if h.wsOwnerCheck != nil {
 		if err := h.wsOwnerCheck(c.Request.Context(), userID, wsID); err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "workspace not found")
 			return
 		}
 	}
 
 	if err := h.bindings.UnbindCredentialFromWorkspace(c.Request.Context(), credID, wsID); err != nil {
 		if errors.Is(err, secrets.ErrAutoBindingProtected) {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusConflict, err.Error())
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to unbind credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to unbind credential")
 		return
 	}
 
@@ -388,24 +393,24 @@ func (h *UserProviderCredentialsHandler) Unbind(c *gin.Context) {
 func (h *UserProviderCredentialsHandler) ListBindings(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 	credID := c.Param("id")
 
 	cred, err := h.store.GetCredential(c.Request.Context(), "user", userID, credID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to verify credential"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to verify credential")
 		return
 	}
 	if cred == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "credential not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "credential not found")
 		return
 	}
 
 	bindings, err := h.bindings.GetCredentialBindingsWithSource(c.Request.Context(), credID, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list bindings"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to list bindings")
 		return
 	}
 
@@ -855,7 +855,7 @@ func TestUserProviderCredentials_Create_InvalidKind_400(t *testing.T) {
 		"invalid kind must surface as 400 from the handler boundary, not 500 from the DB CHECK")
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
-	assert.Equal(t, "kind", resp["field"])
+	assert.Equal(t, "kind", resp["details"].(map[string]any)["field"])
 }
 
 // TestUserProviderCredentials_Create_InvalidSlug_400 — boundary validation
@@ -882,5 +882,5 @@ func TestUserProviderCredentials_Create_InvalidSlug_400(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, w.Code)
 	var resp map[string]any
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
-	assert.Equal(t, "slug", resp["field"])
+	assert.Equal(t, "slug", resp["details"].(map[string]any)["field"])
 }
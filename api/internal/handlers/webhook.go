@@ -15,6 +15,7 @@ import (
 	stripe "github.com/stripe/stripe-go/v76"
 	"github.com/stripe/stripe-go/v76/webhook"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/billing"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -55,24 +56,24 @@ func NewStripeWebhookHandler(provider billing.CheckoutProvider, store stripeEven
 // HandleWebhook is POST /api/v1/webhooks/stripe.
 func (h *StripeWebhookHandler) HandleWebhook(c *gin.Context) {
 	if h == nil || h.provider == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "stripe webhook processing not configured"})
+		apierrors.RespondStatus(c, http.StatusServiceUnavailable, "stripe webhook processing not configured")
 		return
 	}
 
 	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, 1<<20))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	event, err := h.provider.ConstructWebhookEvent(payload, c.GetHeader("Stripe-Signature"))
 	if err != nil {
 		if isSignatureError(err) {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid signature"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "invalid signature")
 			return
 		}
 		h.logger.Error("stripe webhook parse failed", err, "eventID", "")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "malformed webhook"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "malformed webhook")
 		return
 	}
 
@@ -80,7 +81,7 @@ func (h *StripeWebhookHandler) HandleWebhook(c *gin.Context) {
 	// event was fully processed on a prior delivery — return duplicate.
 	inserted, err := h.store.RecordStripeEvent(c.Request.Context(), event.ID, string(event.Type))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to record event"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to record event")
 		return
 	}
 	if !inserted {
@@ -96,7 +97,7 @@ func (h *StripeWebhookHandler) HandleWebhook(c *gin.Context) {
 		if delErr := h.store.DeleteStripeEvent(c.Request.Context(), event.ID); delErr != nil {
 			h.logger.Error("stripe webhook: failed to release claim after dispatch failure", delErr, "eventID", event.ID)
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "event processing failed"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "event processing failed")
 		return
 	}
 
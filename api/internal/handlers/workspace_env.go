@@ -8,9 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
 	"github.com/lenaxia/llmsafespaces/pkg/secrets"
 )
@@ -65,6 +68,44 @@ func (h *WorkspaceEnvHandler) warn(msg string, fields ...interface{}) {
 	}
 }
 
+// envVarNameRE mirrors the agentd materializer's POSIX rule
+// (pkg/agentd/secrets validateVarName) so a bad name is rejected here with
+// a 400 instead of failing later inside the workspace.
+var envVarNameRE = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+const maxEnvVarNameLen = 256
+
+// reservedEnvPrefixes and reservedEnvNames cover the variables the
+// controller sets on the workspace container. agentd never lets a sourced
+// env-secret override an inherited variable, so setting one of these
+// would be silently ignored; reject it up front instead.
+var reservedEnvPrefixes = []string{"LLMSAFESPACE_", "LLMSAFESPACES_", "AGENTD_", "OPENCODE_", "INFERENCE_RELAY_"}
+
+var reservedEnvNames = map[string]struct{}{
+	"WORKSPACE_ID": {}, "WORKSPACE_DIR": {}, "XDG_DATA_HOME": {}, "HOME": {}, "PATH": {},
+}
+
+// validateEnvVarName reports why name cannot be used as a workspace env
+// var, or nil. Reserved names are matched case-insensitively.
+func validateEnvVarName(name string) error {
+	if !envVarNameRE.MatchString(name) {
+		return fmt.Errorf("env var name %q must match [A-Za-z_][A-Za-z0-9_]*", name)
+	}
+	if len(name) > maxEnvVarNameLen {
+		return fmt.Errorf("env var name %q exceeds %d characters", name, maxEnvVarNameLen)
+	}
+	upper := strings.ToUpper(name)
+	if _, ok := reservedEnvNames[upper]; ok {
+		return fmt.Errorf("env var name %q is reserved", name)
+	}
+	for _, prefix := range reservedEnvPrefixes {
+		if strings.HasPrefix(upper, prefix) {
+			return fmt.Errorf("env var name %q is reserved (prefix %s)", name, prefix)
+		}
+	}
+	return nil
+}
+
 // SetWorkspaceEnv handles PUT /api/v1/workspaces/:id/env
 //
 // Creates or updates env-secret type secrets bound to this workspace.
@@ -82,7 +123,7 @@ func (h *WorkspaceEnvHandler) warn(msg string, fields ...interface{}) {
 func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 	userID, sessionID := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -91,10 +132,24 @@ func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 		Vars map[string]string `json:"vars" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "vars map required"})
+		apierrors.RespondStatus(c, http.StatusBadRequest, "vars map required")
 		return
 	}
 
+	// Validate every name before writing anything so a bad name never
+	// leaves the request half-applied. Sorted for a deterministic error.
+	names := make([]string, 0, len(req.Vars))
+	for varName := range req.Vars {
+		names = append(names, varName)
+	}
+	sort.Strings(names)
+	for _, varName := range names {
+		if err := validateEnvVarName(varName); err != nil {
+			apierrors.RespondStatus(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
 	ctx := c.Request.Context()
 
 	newBindings := make([]string, 0, len(req.Vars))
@@ -106,7 +161,7 @@ func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 		if err != nil {
 			h.warn("SetWorkspaceEnv: GetSecretByName failed",
 				"varName", varName, "workspaceID", workspaceID, "error", err.Error())
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to look up env var: " + varName})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to look up env var: "+varName)
 			return
 		}
 		if existing != nil {
@@ -114,7 +169,7 @@ func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 				secrets.UpdateSecretRequest{Value: value}); err != nil {
 				h.warn("SetWorkspaceEnv: UpdateSecret failed",
 					"varName", varName, "secretID", existing.ID, "error", err.Error())
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update env var: " + varName})
+				apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to update env var: "+varName)
 				return
 			}
 			newBindings = append(newBindings, existing.ID)
@@ -127,7 +182,7 @@ func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 		if err != nil {
 			h.warn("SetWorkspaceEnv: CreateSecret failed",
 				"varName", varName, "workspaceID", workspaceID, "error", err.Error())
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set env var: " + varName})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to set env var: "+varName)
 			return
 		}
 		newBindings = append(newBindings, created.ID)
@@ -142,7 +197,7 @@ func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 	if _, err := h.svc.AddBindings(ctx, userID, workspaceID, newBindings); err != nil {
 		h.warn("SetWorkspaceEnv: AddBindings failed",
 			"workspaceID", workspaceID, "error", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to commit workspace bindings"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to commit workspace bindings")
 		return
 	}
 
@@ -154,14 +209,14 @@ func (h *WorkspaceEnvHandler) SetWorkspaceEnv(c *gin.Context) {
 func (h *WorkspaceEnvHandler) GetWorkspaceEnv(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
 	workspaceID := c.Param("id")
 	resp, err := h.svc.GetBindings(c.Request.Context(), userID, workspaceID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get env"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to get env")
 		return
 	}
 
@@ -178,7 +233,7 @@ func (h *WorkspaceEnvHandler) GetWorkspaceEnv(c *gin.Context) {
 func (h *WorkspaceEnvHandler) DeleteWorkspaceEnv(c *gin.Context) {
 	userID, _ := extractAuth(c)
 	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
@@ -190,16 +245,16 @@ func (h *WorkspaceEnvHandler) DeleteWorkspaceEnv(c *gin.Context) {
 	if err != nil {
 		h.warn("DeleteWorkspaceEnv: GetSecretByName failed",
 			"varName", varName, "workspaceID", workspaceID, "error", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to look up env var"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to look up env var")
 		return
 	}
 	if existing == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "env var not found"})
+		apierrors.RespondStatus(c, http.StatusNotFound, "env var not found")
 		return
 	}
 
 	if err := h.svc.DeleteSecret(c.Request.Context(), userID, existing.ID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete env var"})
+		apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to delete env var")
 		return
 	}
 
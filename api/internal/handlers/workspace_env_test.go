@@ -233,6 +233,26 @@ func TestSetWorkspaceEnv_InvalidJSON(t *testing.T) {
 	}
 }
 
+func TestSetWorkspaceEnv_RejectsInvalidAndReservedNames(t *testing.T) {
+	for _, name := range []string{"1FOO", "FOO-BAR", "WORKSPACE_ID", "path", "LLMSAFESPACE_API_URL", "agentd_admin_token", "OPENCODE_CONFIG"} {
+		t.Run(name, func(t *testing.T) {
+			svc := newMockEnvService()
+			r := setupEnvRouter(svc)
+
+			w := doEnvRequest(r, "PUT", "/workspaces/ws-1/env",
+				`{"vars":{"OK_VAR":"v","`+name+`":"x"}}`)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status: want 400, got %d (body=%s)", w.Code, w.Body.String())
+			}
+			if svc.createCallCount != 0 || svc.addBindCallCount != 0 {
+				t.Errorf("nothing may be written when any name is invalid (creates=%d, binds=%d)",
+					svc.createCallCount, svc.addBindCallCount)
+			}
+		})
+	}
+}
+
 func TestSetWorkspaceEnv_EmptyVarsMap_NoOpSuccess(t *testing.T) {
 	// An empty (non-nil) vars map satisfies binding:"required" and is a
 	// valid no-op: no secrets to create, AddBindings with empty slice.
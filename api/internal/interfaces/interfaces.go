@@ -79,6 +79,7 @@ type DatabaseService interface {
 	UpdateWorkspace(ctx context.Context, workspaceID string, updates types.WorkspaceUpdates) error
 	DeleteWorkspace(ctx context.Context, workspaceID string) error
 	ListWorkspaces(ctx context.Context, userID string, limit, offset int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error)
+	ListWorkspacesFiltered(ctx context.Context, userID string, filter types.WorkspaceListFilter, limit, offset int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error)
 	CountWorkspacesByUserAndOrg(ctx context.Context, userID, orgID string) (int, error)
 	CountActiveWorkspacesByUserAndOrg(ctx context.Context, userID, orgID string) (int, error)
 	SyncWorkspaceVersionInfo(ctx context.Context, workspaceID, imageTag, agentVersion string)
@@ -144,16 +145,21 @@ type WorkspaceService interface {
 	CheckOwnership(ctx context.Context, userID string, meta *types.WorkspaceMetadata) error
 	ListWorkspaces(ctx context.Context, userID string, opts types.ListOptions) (*types.WorkspaceListResult, error)
 	DeleteWorkspace(ctx context.Context, userID, workspaceID string) error
+	DeleteWorkspacesByLabel(ctx context.Context, userID, selector string) (*types.BulkDeleteWorkspacesResult, error)
 	SuspendWorkspace(ctx context.Context, userID, workspaceID string) error
 	RestartWorkspace(ctx context.Context, userID, workspaceID string) error
 	RefreshWorkspaceCompute(ctx context.Context, userID, workspaceID string) (*types.RefreshWorkspaceResult, error)
 	GetWorkspaceStatus(ctx context.Context, userID, workspaceID string) (*types.WorkspaceStatusResult, error)
+	WaitWorkspaceStatus(ctx context.Context, userID, workspaceID string, timeout time.Duration) (*types.WorkspaceStatusResult, error)
 	ActivateWorkspace(ctx context.Context, userID, workspaceID string) (*types.ActivateWorkspaceResponse, error)
 	EnsureSession(ctx context.Context, userID, workspaceID string) (*types.EnsureSessionResponse, error)
 	ListWorkspaceSessions(ctx context.Context, userID, workspaceID string) ([]types.SessionListItem, error)
 	RenameSession(ctx context.Context, userID, workspaceID, sessionID, title string) error
 	MarkSessionSeen(ctx context.Context, userID, workspaceID, sessionID string) error
 	RenameWorkspace(ctx context.Context, userID, workspaceID, name string) error
+	// ForceCleanWorkspace strips finalizers from a workspace stuck
+	// terminating and force-deletes its pod. Admin-only: no owner check.
+	ForceCleanWorkspace(ctx context.Context, workspaceID string) (*types.ForceCleanWorkspaceResult, error)
 	Start() error
 	Stop() error
 }
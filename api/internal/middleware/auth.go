@@ -81,9 +81,7 @@ func AuthMiddleware(authService apiinterfaces.AuthService, log pkginterfaces.Log
 			metrics.RecordAuthFailure("missing_token")
 			metrics.RecordAuthAttempt(authMethodForToken(""), "failure")
 			apiErr := errors.NewAuthenticationError("Authentication required", nil)
-			c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-				"error": apiErr.Message,
-			})
+			errors.RespondStatus(c, apiErr.StatusCode(), apiErr.Message)
 			return
 		}
 
@@ -103,9 +101,7 @@ func AuthMiddleware(authService apiinterfaces.AuthService, log pkginterfaces.Log
 			metrics.RecordAuthFailure("invalid_token")
 			metrics.RecordAuthAttempt(authMethodForToken(token), "failure")
 			apiErr := errors.NewAuthenticationError("Invalid or expired token", nil)
-			c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-				"error": apiErr.Message,
-			})
+			errors.RespondStatus(c, apiErr.StatusCode(), apiErr.Message)
 			return
 		}
 
@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"runtime/debug"
 	"strings"
 
@@ -206,9 +205,6 @@ func logError(log interfaces.LoggerInterface, c *gin.Context, err error, request
 func handleError(c *gin.Context, err error, cfg ErrorHandlerConfig) {
 	// Check if it's an API error
 	if apiErr, ok := err.(*apiErrors.APIError); ok {
-		// Get status code from API error
-		statusCode := apiErr.StatusCode()
-
 		// Add rate limit headers if applicable
 		if apiErr.Type == apiErrors.ErrorTypeRateLimit {
 			if limit, ok := apiErr.Details["limit"].(int); ok {
@@ -228,32 +224,21 @@ func handleError(c *gin.Context, err error, cfg ErrorHandlerConfig) {
 		}
 
 		// Send error response
-		c.AbortWithStatusJSON(statusCode, gin.H{
-			"error": gin.H{
-				"code":    apiErr.Code,
-				"message": apiErr.Message,
-				"details": apiErr.Details,
-			},
-		})
+		apiErrors.Respond(c, apiErr)
 		return
 	}
 
 	// Handle generic errors
-	errorResponse := gin.H{
-		"error": gin.H{
-			"code":    "internal_error",
-			"message": "An unexpected error occurred",
-		},
-	}
+	apiErr := apiErrors.NewInternalError("An unexpected error occurred", err)
 
 	// Include stack trace if configured
 	if cfg.IncludeStackTrace {
-		errorResponse["error"].(gin.H)["details"] = gin.H{
+		apiErr.Details = map[string]interface{}{
 			"stack": strings.Split(string(debug.Stack()), "\n"),
 		}
 	}
 
-	c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse)
+	apiErrors.Respond(c, apiErr)
 }
 
 // HandleAPIError handles an API error in a handler
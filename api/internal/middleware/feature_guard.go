@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/billing"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
@@ -37,23 +38,22 @@ func FeatureGuard(reader orgPlanReader, feature string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		orgID := c.Param("id")
 		if orgID == "" {
-			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "org id required"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "org id required")
 			return
 		}
 
 		org, err := reader.GetOrg(c.Request.Context(), orgID)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to load organization"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to load organization")
 			return
 		}
 		if org == nil {
-			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "organization not found"})
+			apierrors.RespondStatus(c, http.StatusNotFound, "organization not found")
 			return
 		}
 
 		if !billing.IsFeatureAllowed(org.PlanID, feature) {
-			c.AbortWithStatusJSON(http.StatusPaymentRequired, gin.H{
-				"error":   "feature not included in current plan",
+			apierrors.RespondDetails(c, http.StatusPaymentRequired, "feature not included in current plan", map[string]interface{}{
 				"feature": feature,
 				"planId":  org.PlanID,
 				"hint":    "upgrade your subscription to access this feature",
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 )
 
 // orgMemberChecker is the minimal interface required by the org guard middlewares.
@@ -24,23 +25,23 @@ func OrgMemberGuard(store orgMemberChecker) gin.HandlerFunc {
 		userID, _ := c.Get("userID")
 		uid, _ := userID.(string)
 		if uid == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 
 		orgID := c.Param("id")
 		if orgID == "" {
-			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "org id required"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "org id required")
 			return
 		}
 
 		ok, err := store.IsOrgMember(c.Request.Context(), orgID, uid)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check org membership"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check org membership")
 			return
 		}
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "not a member of this organization"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "not a member of this organization")
 			return
 		}
 
@@ -56,23 +57,23 @@ func OrgAdminGuard(store orgMemberChecker) gin.HandlerFunc {
 		userID, _ := c.Get("userID")
 		uid, _ := userID.(string)
 		if uid == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 
 		orgID := c.Param("id")
 		if orgID == "" {
-			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "org id required"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "org id required")
 			return
 		}
 
 		ok, err := store.IsOrgAdmin(c.Request.Context(), orgID, uid)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check org admin status"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to check org admin status")
 			return
 		}
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			apierrors.RespondStatus(c, http.StatusForbidden, "admin access required")
 			return
 		}
 
@@ -132,13 +132,7 @@ func RateLimitMiddleware(rl interfaces.RateLimiterService, log pkginterfaces.Log
 				c.Header("X-RateLimit-Limit", strconv.Itoa(limit))
 				c.Header("X-RateLimit-Remaining", "0")
 				c.Header("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(window).Unix(), 10))
-				c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-					"error": gin.H{
-						"code":    apiErr.Code,
-						"message": apiErr.Message,
-						"details": apiErr.Details,
-					},
-				})
+				errors.Respond(c, apiErr)
 				return
 			}
 			_ = c.AbortWithError(http.StatusInternalServerError, err)
@@ -6,7 +6,6 @@ package middleware
 import (
 	"fmt"
 	"net"
-	"net/http"
 	"os"
 	"runtime/debug"
 	"strings"
@@ -124,14 +123,7 @@ func RecoveryMiddleware(log interfaces.LoggerInterface, config ...RecoveryConfig
 				}
 
 				// Send error response
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error": gin.H{
-						"code":    apiErr.Code,
-						"message": apiErr.Message,
-						"details": apiErr.Details,
-					},
-				})
-				c.Abort()
+				errors.Respond(c, apiErr)
 			}
 		}()
 
@@ -222,12 +222,7 @@ func WebSocketSecurityMiddleware(log interfaces.LoggerInterface, allowedOrigins
 				)
 
 				apiErr := errors.NewForbiddenError("Origin header is required for WebSocket connections", nil)
-				c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-					"error": gin.H{
-						"code":    apiErr.Code,
-						"message": apiErr.Message,
-					},
-				})
+				errors.Respond(c, apiErr)
 				return
 			}
 
@@ -249,12 +244,7 @@ func WebSocketSecurityMiddleware(log interfaces.LoggerInterface, allowedOrigins
 				)
 
 				apiErr := errors.NewForbiddenError("Origin not allowed", nil)
-				c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-					"error": gin.H{
-						"code":    apiErr.Code,
-						"message": apiErr.Message,
-					},
-				})
+				errors.Respond(c, apiErr)
 				return
 			}
 
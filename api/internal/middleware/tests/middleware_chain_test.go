@@ -160,7 +160,7 @@ func TestMiddlewareErrorPropagation(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
 	assert.Contains(t, response, "error")
-	assert.Equal(t, "unauthorized", response["error"].(map[string]interface{})["code"])
+	assert.Equal(t, "unauthorized", response["code"])
 
 	// Check execution order - validation, handler, and anything after auth should not be called
 	expectedOrder := []string{
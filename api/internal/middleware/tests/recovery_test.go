@@ -122,7 +122,7 @@ func TestRecoveryMiddleware_StackTraceInResponse(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
 
-	errorDetails := response["error"].(map[string]interface{})["details"].(map[string]interface{})
+	errorDetails := response["details"].(map[string]interface{})
 	stackTrace := errorDetails["stack"].([]interface{})
 	assert.NotEmpty(t, stackTrace)
 
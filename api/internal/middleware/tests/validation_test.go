@@ -120,12 +120,11 @@ func TestValidationMiddleware_InvalidRequest(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Check error structure
-	errorObj := response["error"].(map[string]interface{})
-	assert.Equal(t, "validation_error", errorObj["code"])
-	assert.Contains(t, errorObj["message"], "Validation failed")
+	assert.Equal(t, "validation_error", response["code"])
+	assert.Contains(t, response["message"], "Validation failed")
 
 	// Check validation errors
-	details := errorObj["details"].(map[string]interface{})
+	details := response["details"].(map[string]interface{})
 	errors := details["errors"].(map[string]interface{})
 
 	assert.Contains(t, errors, "username")
@@ -171,9 +170,8 @@ func TestValidationMiddleware_InvalidJSON(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Check error structure
-	errorObj := response["error"].(map[string]interface{})
-	assert.Equal(t, "validation_error", errorObj["code"])
-	assert.Contains(t, errorObj["message"], "Invalid request body")
+	assert.Equal(t, "validation_error", response["code"])
+	assert.Contains(t, response["message"], "Invalid request body")
 
 	mockLogger.AssertExpectations(t)
 }
@@ -248,8 +246,7 @@ func TestValidationMiddleware_CustomValidator(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Check custom error message
-	errorObj := response["error"].(map[string]interface{})
-	details := errorObj["details"].(map[string]interface{})
+	details := response["details"].(map[string]interface{})
 	errors := details["errors"].(map[string]interface{})
 
 	assert.Contains(t, errors["name"], "Must contain only alphabetic characters")
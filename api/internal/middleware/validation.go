@@ -121,13 +121,7 @@ func ValidationMiddleware(log interfaces.LoggerInterface, config ...ValidationCo
 					"body": "Invalid JSON format",
 				},
 			}, err)
-			c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-				"error": gin.H{
-					"code":    apiErr.Code,
-					"message": apiErr.Message,
-					"details": apiErr.Details,
-				},
-			})
+			errors.Respond(c, apiErr)
 			return
 		}
 
@@ -162,13 +156,7 @@ func ValidationMiddleware(log interfaces.LoggerInterface, config ...ValidationCo
 			apiErr := errors.NewValidationError("Validation failed", map[string]interface{}{
 				"errors": validationErrors,
 			}, err)
-			c.AbortWithStatusJSON(apiErr.StatusCode(), gin.H{
-				"error": gin.H{
-					"code":    apiErr.Code,
-					"message": apiErr.Message,
-					"details": apiErr.Details,
-				},
-			})
+			errors.Respond(c, apiErr)
 			return
 		}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
 )
 
@@ -51,24 +52,24 @@ func WorkspaceAccessMiddleware(svc workspaceAccessService) gin.HandlerFunc {
 		userID, _ := c.Get("userID")
 		uid, _ := userID.(string)
 		if uid == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 
 		workspaceID := c.Param("id")
 		if workspaceID == "" {
-			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "workspace id required"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "workspace id required")
 			return
 		}
 
 		meta, err := svc.ResolveWorkspace(c.Request.Context(), workspaceID)
 		if err != nil {
-			respondWithAPIError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 
 		if err := svc.CheckOwnership(c.Request.Context(), uid, meta); err != nil {
-			respondWithAPIError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 
@@ -76,23 +77,3 @@ func WorkspaceAccessMiddleware(svc workspaceAccessService) gin.HandlerFunc {
 		c.Next()
 	}
 }
-
-// apiErrorMatcher is the minimal anonymous interface used to map APIError-like
-// failures to their declared HTTP status without importing the errors package
-// (keeps the middleware decoupled, mirroring server.respondWithError).
-type apiErrorMatcher interface {
-	StatusCode() int
-	Error() string
-}
-
-// respondWithAPIError maps a service error to the gin response. APIError-shaped
-// failures use their StatusCode; everything else is a 500. This intentionally
-// duplicates server.respondWithError's logic so the middleware can stay
-// decoupled from the server package (avoiding an import cycle).
-func respondWithAPIError(c *gin.Context, err error) {
-	if ae, ok := err.(apiErrorMatcher); ok {
-		c.AbortWithStatusJSON(ae.StatusCode(), gin.H{"error": ae.Error()})
-		return
-	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-}
@@ -142,6 +142,19 @@ func (m *MockDatabaseService) ListWorkspaces(ctx context.Context, userID string,
 	return workspaces, pagination, args.Error(2)
 }
 
+func (m *MockDatabaseService) ListWorkspacesFiltered(ctx context.Context, userID string, filter types.WorkspaceListFilter, limit, offset int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	args := m.Called(ctx, userID, filter, limit, offset)
+	var workspaces []*types.WorkspaceMetadata
+	if args.Get(0) != nil {
+		workspaces = args.Get(0).([]*types.WorkspaceMetadata)
+	}
+	var pagination *types.PaginationMetadata
+	if args.Get(1) != nil {
+		pagination = args.Get(1).(*types.PaginationMetadata)
+	}
+	return workspaces, pagination, args.Error(2)
+}
+
 func (m *MockDatabaseService) CountWorkspacesByUserAndOrg(ctx context.Context, userID, orgID string) (int, error) {
 	args := m.Called(ctx, userID, orgID)
 	return args.Int(0), args.Error(1)
@@ -5,6 +5,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/lenaxia/llmsafespaces/api/internal/interfaces"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
@@ -58,6 +59,22 @@ func (m *MockWorkspaceService) DeleteWorkspace(ctx context.Context, userID, work
 	return m.Called(ctx, userID, workspaceID).Error(0)
 }
 
+func (m *MockWorkspaceService) DeleteWorkspacesByLabel(ctx context.Context, userID, selector string) (*types.BulkDeleteWorkspacesResult, error) {
+	args := m.Called(ctx, userID, selector)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.BulkDeleteWorkspacesResult), args.Error(1)
+}
+
+func (m *MockWorkspaceService) ForceCleanWorkspace(ctx context.Context, workspaceID string) (*types.ForceCleanWorkspaceResult, error) {
+	args := m.Called(ctx, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.ForceCleanWorkspaceResult), args.Error(1)
+}
+
 func (m *MockWorkspaceService) SuspendWorkspace(ctx context.Context, userID, workspaceID string) error {
 	return m.Called(ctx, userID, workspaceID).Error(0)
 }
@@ -82,6 +99,14 @@ func (m *MockWorkspaceService) GetWorkspaceStatus(ctx context.Context, userID, w
 	return args.Get(0).(*types.WorkspaceStatusResult), args.Error(1)
 }
 
+func (m *MockWorkspaceService) WaitWorkspaceStatus(ctx context.Context, userID, workspaceID string, timeout time.Duration) (*types.WorkspaceStatusResult, error) {
+	args := m.Called(ctx, userID, workspaceID, timeout)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.WorkspaceStatusResult), args.Error(1)
+}
+
 func (m *MockWorkspaceService) Start() error { return m.Called().Error(0) }
 func (m *MockWorkspaceService) Stop() error  { return m.Called().Error(0) }
 
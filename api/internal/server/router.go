@@ -10,9 +10,11 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
@@ -21,6 +23,7 @@ import (
 	apilogger "github.com/lenaxia/llmsafespaces/api/internal/logger"
 	"github.com/lenaxia/llmsafespaces/api/internal/middleware"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/auth"
+	"github.com/lenaxia/llmsafespaces/api/internal/services/metrics"
 	"github.com/lenaxia/llmsafespaces/api/internal/services/workspace"
 	"github.com/lenaxia/llmsafespaces/api/internal/utilities"
 	"github.com/lenaxia/llmsafespaces/pkg/settings"
@@ -44,9 +47,6 @@ type RouterConfig struct {
 	// TracingConfig is the configuration for the tracing middleware
 	TracingConfig middleware.TracingConfig
 
-	// AllowedWebSocketOrigins is a list of allowed origins for WebSocket connections
-	AllowedWebSocketOrigins []string
-
 	// SettingsHandler is the optional settings handler for admin/user settings routes
 	SettingsHandler *handlers.SettingsHandler
 
@@ -112,6 +112,9 @@ type RouterConfig struct {
 	// US-44.11: force-abort a workspace session stuck in activeSess after the
 	// workspace pod was deleted/unreachable.
 	AdminSessionHandler *handlers.AdminSessionHandler
+	// AdminWorkspaceHandler handles admin-only workspace recovery
+	// (force-clean of workspaces stuck terminating). Optional.
+	AdminWorkspaceHandler *handlers.AdminWorkspaceHandler
 
 	// PlatformAdminHandler handles platform-admin org/user suspension
 	// endpoints (US-43.19, D19/D20). Mounted behind AuthMiddleware + AdminGuard.
@@ -170,12 +173,11 @@ func DefaultRouterConfig() RouterConfig {
 	// after network drops don't trigger 429s.
 	rlCfg.ExemptPaths = []string{"/events", "/session-events"}
 	return RouterConfig{
-		Debug:                   false,
-		LoggingConfig:           middleware.DefaultLoggingConfig(),
-		RateLimitConfig:         rlCfg,
-		SecurityConfig:          middleware.DefaultSecurityConfig(),
-		TracingConfig:           middleware.DefaultTracingConfig(),
-		AllowedWebSocketOrigins: []string{"*"},
+		Debug:           false,
+		LoggingConfig:   middleware.DefaultLoggingConfig(),
+		RateLimitConfig: rlCfg,
+		SecurityConfig:  middleware.DefaultSecurityConfig(),
+		TracingConfig:   middleware.DefaultTracingConfig(),
 	}
 }
 
@@ -270,7 +272,7 @@ func NewRouter(services interfaces.Services, logger *apilogger.Logger, proxyHand
 		idGroup.GET("/sessions/active", func(c *gin.Context) {
 			userID := services.GetAuth().GetUserID(c)
 			if userID == "" {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+				apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 				return
 			}
 			workspaceID := c.Param("id")
@@ -402,6 +404,12 @@ func NewRouter(services interfaces.Services, logger *apilogger.Logger, proxyHand
 		adminSessions.Use(middleware.AdminGuard())
 		adminSessions.POST("/:sessionId/force-abort", cfg.AdminSessionHandler.ForceAbortSession)
 	}
+	if cfg.AdminWorkspaceHandler != nil {
+		adminWorkspaces := router.Group("/api/v1/admin/workspaces/:workspaceId")
+		adminWorkspaces.Use(services.GetAuth().AuthMiddleware())
+		adminWorkspaces.Use(middleware.AdminGuard())
+		adminWorkspaces.POST("/force-clean", cfg.AdminWorkspaceHandler.ForceClean)
+	}
 
 	// US-43.20: Cross-org audit view (platform admin only).
 	if cfg.AuditHandler != nil {
@@ -440,6 +448,7 @@ func NewRouter(services interfaces.Services, logger *apilogger.Logger, proxyHand
 		suspendGrp.Use(middleware.AdminGuard())
 		suspendGrp.GET("/orgs", cfg.PlatformAdminHandler.ListOrgs)
 		suspendGrp.GET("/users", cfg.PlatformAdminHandler.ListUsers)
+		suspendGrp.POST("/users/import", cfg.PlatformAdminHandler.ImportUsers)
 		suspendGrp.POST("/orgs/:id/suspend", cfg.PlatformAdminHandler.SuspendOrg)
 		suspendGrp.POST("/orgs/:id/unsuspend", cfg.PlatformAdminHandler.UnsuspendOrg)
 		suspendGrp.POST("/users/:id/suspend", cfg.PlatformAdminHandler.SuspendUser)
@@ -542,16 +551,36 @@ func NewRouter(services interfaces.Services, logger *apilogger.Logger, proxyHand
 	// LLMSAFESPACES_METRICS_TOKEN is set. Operators who want
 	// Prometheus to scrape unauthenticated should leave the env unset
 	// (matching the pre-fix behavior with explicit opt-in).
-	router.GET("/metrics", func(c *gin.Context) {
+	metricsAuthorized := func(c *gin.Context) bool {
 		token := os.Getenv("LLMSAFESPACES_METRICS_TOKEN")
 		if token != "" && c.GetHeader("Authorization") != "Bearer "+token {
 			c.Header("WWW-Authenticate", `Bearer realm="metrics"`)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "unauthorized")
+			return false
+		}
+		return true
+	}
+	router.GET("/metrics", func(c *gin.Context) {
+		if !metricsAuthorized(c) {
 			return
 		}
 		promhttp.Handler().ServeHTTP(c.Writer, c.Request)
 	})
 
+	// Point-in-time JSON summary of the key counters on /metrics, for
+	// embedding in reports. Same token guard as /metrics.
+	router.GET("/metrics/snapshot", func(c *gin.Context) {
+		if !metricsAuthorized(c) {
+			return
+		}
+		snap, err := metrics.Snapshot(prometheus.DefaultGatherer, time.Now())
+		if err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, snap)
+	})
+
 	// Liveness probe — always returns 200 if the process is responding.
 	// Use this for Kubernetes livenessProbe.
 	livenessHandler := func(c *gin.Context) {
@@ -678,12 +707,12 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAuthBodyBytes)
 		var req types.RegisterRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": sanitizeBindError(err)})
+			apierrors.RespondStatus(c, http.StatusBadRequest, sanitizeBindError(err))
 			return
 		}
 		resp, err := authSvc.Register(c.Request.Context(), req)
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		maxAge := int(resp.TokenTTL.Seconds())
@@ -698,16 +727,18 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAuthBodyBytes)
 		var req types.LoginRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": sanitizeBindError(err)})
+			apierrors.RespondStatus(c, http.StatusBadRequest, sanitizeBindError(err))
 			return
 		}
 		resp, err := authSvc.Login(c.Request.Context(), req)
 		if err != nil {
 			if errors.Is(err, auth.ErrEmailNotVerified) {
-				c.JSON(http.StatusForbidden, gin.H{"error": err.Error(), "emailVerified": false})
+				apierrors.RespondDetails(c, http.StatusForbidden, err.Error(), map[string]interface{}{
+					"emailVerified": false,
+				})
 				return
 			}
-			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, err.Error())
 			return
 		}
 		maxAge := int(resp.TokenTTL.Seconds())
@@ -763,12 +794,12 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 	meGroup.GET("/me", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		user, err := services.GetDatabase().GetUser(c.Request.Context(), userID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch user"})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, "failed to fetch user")
 			return
 		}
 		c.JSON(http.StatusOK, user)
@@ -780,12 +811,12 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAuthBodyBytes)
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		var req types.CreateAPIKeyRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": sanitizeBindError(err)})
+			apierrors.RespondStatus(c, http.StatusBadRequest, sanitizeBindError(err))
 			return
 		}
 		sessionID, _ := c.Get("sessionID")
@@ -799,7 +830,7 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 		}
 		apiKey, err := authSvc.CreateAPIKey(c.Request.Context(), userID, req, sid, matchedKey)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, err.Error())
 			return
 		}
 		c.JSON(http.StatusCreated, apiKey)
@@ -807,12 +838,12 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 	apiKeyGroup.GET("/api-keys", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		keys, err := authSvc.ListAPIKeys(c.Request.Context(), userID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, err.Error())
 			return
 		}
 		if keys == nil {
@@ -823,11 +854,11 @@ func registerAuthRoutes(rg *gin.RouterGroup, services interfaces.Services, insta
 	apiKeyGroup.DELETE("/api-keys/:id", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		if err := authSvc.DeleteAPIKey(c.Request.Context(), userID, c.Param("id")); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusInternalServerError, err.Error())
 			return
 		}
 		c.Status(http.StatusNoContent)
@@ -851,7 +882,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	rg.GET("", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		limit := 20
@@ -866,9 +897,33 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 				offset = n
 			}
 		}
-		result, err := wsSvc.ListWorkspaces(c.Request.Context(), userID, types.ListOptions{Limit: limit, Offset: offset})
+		result, err := wsSvc.ListWorkspaces(c.Request.Context(), userID, types.ListOptions{
+			Limit:   limit,
+			Offset:  offset,
+			Phase:   c.Query("status"),
+			Runtime: c.Query("runtime"),
+			Sort:    c.Query("sort"),
+			Order:   c.Query("order"),
+		})
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, result)
+	})
+
+	// DELETE /workspaces?labelSelector=... deletes every workspace of the
+	// caller matching the selector and reports what was deleted, skipped,
+	// or failed. A missing selector is rejected rather than deleting all.
+	rg.DELETE("", func(c *gin.Context) {
+		userID := authSvc.GetUserID(c)
+		if userID == "" {
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
+			return
+		}
+		result, err := wsSvc.DeleteWorkspacesByLabel(c.Request.Context(), userID, c.Query("labelSelector"))
+		if err != nil {
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, result)
@@ -877,7 +932,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	rg.POST("", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 
@@ -890,8 +945,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 			if cap, parseErr := strconv.Atoi(maxWS); parseErr == nil && cap > 0 {
 				_, page, err := services.GetDatabase().ListWorkspaces(c.Request.Context(), userID, 1, 0)
 				if err == nil && page != nil && page.Total >= cap {
-					c.JSON(http.StatusTooManyRequests, gin.H{
-						"error": "workspace quota exceeded",
+					apierrors.RespondDetails(c, http.StatusTooManyRequests, "workspace quota exceeded", map[string]interface{}{
 						"limit": cap,
 					})
 					return
@@ -901,16 +955,21 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 
 		var req types.CreateWorkspaceRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			apierrors.RespondStatus(c, http.StatusBadRequest, sanitizeBindError(err))
 			return
 		}
 		ctx := c.Request.Context()
 		if sid, exists := c.Get("sessionID"); exists {
 			ctx = workspace.ContextWithSessionID(ctx, sid.(string))
 		}
+		if role, ok := c.Get("userRole"); ok {
+			if r, ok := role.(string); ok {
+				ctx = workspace.ContextWithUserRole(ctx, r)
+			}
+		}
 		ws, err := wsSvc.CreateWorkspace(ctx, userID, req)
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusCreated, ws)
@@ -919,12 +978,18 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.GET("", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
-		ws, err := wsSvc.GetWorkspace(c.Request.Context(), userID, c.Param("id"))
+		// Clients polling the phase can send Cache-Control: no-cache to
+		// skip the service's short-TTL workspace cache.
+		ctx := c.Request.Context()
+		if strings.Contains(c.GetHeader("Cache-Control"), "no-cache") {
+			ctx = types.WithFreshWorkspaceRead(ctx)
+		}
+		ws, err := wsSvc.GetWorkspace(ctx, userID, c.Param("id"))
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, ws)
@@ -933,18 +998,18 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.PUT("", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		var body struct {
 			Name string `json:"name" binding:"required"`
 		}
 		if err := c.ShouldBindJSON(&body); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "name is required")
 			return
 		}
 		if err := wsSvc.RenameWorkspace(c.Request.Context(), userID, c.Param("id"), body.Name); err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.Status(http.StatusNoContent)
@@ -953,11 +1018,11 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.DELETE("", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		if err := wsSvc.DeleteWorkspace(c.Request.Context(), userID, c.Param("id")); err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.Status(http.StatusNoContent)
@@ -966,11 +1031,11 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.POST("/suspend", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		if err := wsSvc.SuspendWorkspace(c.Request.Context(), userID, c.Param("id")); err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.Status(http.StatusAccepted)
@@ -986,7 +1051,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.POST("/restart", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		restartCtx := c.Request.Context()
@@ -994,7 +1059,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 			restartCtx = workspace.ContextWithSessionID(restartCtx, sid.(string))
 		}
 		if err := wsSvc.RestartWorkspace(restartCtx, userID, c.Param("id")); err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.Status(http.StatusAccepted)
@@ -1007,7 +1072,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.POST("/refresh-compute", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		ctx := c.Request.Context()
@@ -1016,7 +1081,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 		}
 		resp, err := wsSvc.RefreshWorkspaceCompute(ctx, userID, c.Param("id"))
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusAccepted, resp)
@@ -1030,12 +1095,27 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.GET("/status", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
-		status, err := wsSvc.GetWorkspaceStatus(c.Request.Context(), userID, c.Param("id"))
+		// ?wait=true long-polls until the status changes or ?timeout
+		// (Go duration or whole seconds, capped server-side) elapses.
+		var status *types.WorkspaceStatusResult
+		var err error
+		if c.Query("wait") == "true" {
+			timeout, ok := parseStatusWaitTimeout(c.Query("timeout"))
+			if !ok {
+				apierrors.Respond(c, apierrors.NewValidationError(
+					"invalid timeout: must be a positive duration or whole seconds",
+					map[string]interface{}{"field": "timeout"}, nil))
+				return
+			}
+			status, err = wsSvc.WaitWorkspaceStatus(c.Request.Context(), userID, c.Param("id"), timeout)
+		} else {
+			status, err = wsSvc.GetWorkspaceStatus(c.Request.Context(), userID, c.Param("id"))
+		}
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, status)
@@ -1044,7 +1124,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.POST("/activate", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		ctx := c.Request.Context()
@@ -1053,7 +1133,7 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 		}
 		resp, err := wsSvc.ActivateWorkspace(ctx, userID, c.Param("id"))
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
@@ -1062,13 +1142,13 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.GET("/sessions", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		workspaceID := c.Param("id")
 		sessions, err := wsSvc.ListWorkspaceSessions(c.Request.Context(), userID, workspaceID)
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		// Trigger a one-shot async backfill of parent_session_id from the
@@ -1098,12 +1178,12 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.POST("/sessions/new", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		resp, err := wsSvc.EnsureSession(c.Request.Context(), userID, c.Param("id"))
 		if err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
@@ -1112,20 +1192,20 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.PUT("/sessions/:sessionId/title", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		var body struct {
 			Title string `json:"title" binding:"required"`
 		}
 		if err := c.ShouldBindJSON(&body); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "title is required"})
+			apierrors.RespondStatus(c, http.StatusBadRequest, "title is required")
 			return
 		}
 		wsID := c.Param("id")
 		sID := c.Param("sessionId")
 		if err := wsSvc.RenameSession(c.Request.Context(), userID, wsID, sID, body.Title); err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		// Also rename in the opencode agent so the frontend's periodic title
@@ -1147,11 +1227,11 @@ func registerWorkspaceRoutes(rg *gin.RouterGroup, idGroup *gin.RouterGroup, serv
 	idGroup.PUT("/sessions/:sessionId/seen", func(c *gin.Context) {
 		userID := authSvc.GetUserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+			apierrors.RespondStatus(c, http.StatusUnauthorized, "authentication required")
 			return
 		}
 		if err := wsSvc.MarkSessionSeen(c.Request.Context(), userID, c.Param("id"), c.Param("sessionId")); err != nil {
-			respondWithError(c, err)
+			apierrors.Respond(c, err)
 			return
 		}
 		c.Status(http.StatusNoContent)
@@ -1185,6 +1265,7 @@ func registerProxyRoutes(idGroup *gin.RouterGroup, proxyHandler *handlers.ProxyH
 	idGroup.POST("/sessions/:sessionId/abort", proxyHandler.AbortSession)
 	idGroup.DELETE("/sessions/:sessionId", proxyHandler.DeleteSession)
 	idGroup.GET("/session-events", proxyHandler.StreamEvents)
+	idGroup.GET("/metrics", proxyHandler.GetWorkspaceMetrics)
 
 	// Question/Permission input request routes (Epic 16)
 	idGroup.GET("/question", proxyHandler.ListQuestions)
@@ -1194,22 +1275,23 @@ func registerProxyRoutes(idGroup *gin.RouterGroup, proxyHandler *handlers.ProxyH
 	idGroup.POST("/permission/:requestID/reply", proxyHandler.PermissionReply)
 }
 
-// respondWithError maps API errors to HTTP responses. It uses errors.As to
-// find an *apierrors.APIError or a *pkgerrors.StatusError anywhere in the
-// error chain (handles both direct returns and fmt.Errorf-wrapped errors),
-// falling back to a duck-typed StatusCode() check, then 500 for plain errors.
-func respondWithError(c *gin.Context, err error) {
-	var apiErr *apierrors.APIError
-	if errors.As(err, &apiErr) {
-		c.JSON(apiErr.StatusCode(), gin.H{"error": apiErr.Error()})
-		return
+// parseStatusWaitTimeout parses the long-poll ?timeout value. Empty means
+// the service default; bare integers are seconds.
+func parseStatusWaitTimeout(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, true
+	}
+	if n, err := strconv.Atoi(v); err == nil {
+		if n <= 0 {
+			return 0, false
+		}
+		return time.Duration(n) * time.Second, true
 	}
-	var statusErr interface{ StatusCode() int }
-	if errors.As(err, &statusErr) {
-		c.JSON(statusErr.StatusCode(), gin.H{"error": err.Error()})
-		return
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, false
 	}
-	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	return d, true
 }
 
 // registerSettingsRoutes adds admin and user settings routes.
@@ -122,3 +122,15 @@ func TestPlatformListRoutes_AdminGuard_AllowsAdmin(t *testing.T) {
 		assert.Contains(t, rec.Body.String(), "a@example.com", "response must contain the user item")
 	})
 }
+
+// TestPlatformImportRoute_Registered confirms POST /api/v1/admin/users/import
+// routes to ImportUsers alongside /users/:id/* and sits behind AdminGuard.
+func TestPlatformImportRoute_Registered(t *testing.T) {
+	for role, want := range map[string]int{"user": http.StatusNotFound, "admin": http.StatusNotImplemented} {
+		router := newPlatformListRouter(t, role)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/import", nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, want, rec.Code, "role=%s body=%s", role, rec.Body.String())
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+)
+
+// TestWorkspaceRoutes_FailurePathCodes pins the `code` each workspace
+// failure path reports through the real router and handlers. Clients
+// switch on these values, so a change here is an API break.
+func TestWorkspaceRoutes_FailurePathCodes(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		setup  func(svc *mockServices)
+		status int
+		code   string
+	}{
+		{
+			name:   "malformed body",
+			method: http.MethodPut, path: "/api/v1/workspaces/ws-1/sessions/sess-1/title", body: `{}`,
+			status: http.StatusBadRequest, code: apierrors.CodeBadRequest,
+		},
+		{
+			name:   "invalid list parameter",
+			method: http.MethodGet, path: "/api/v1/workspaces?sort=name",
+			setup: func(svc *mockServices) {
+				svc.workspace.On("ListWorkspaces", mock.Anything, "test-user", mock.Anything).Return(nil,
+					apierrors.NewValidationError("invalid sort", map[string]interface{}{"field": "sort"}, nil))
+			},
+			status: http.StatusUnprocessableEntity, code: apierrors.CodeValidation,
+		},
+		{
+			name:   "unsupported runtime",
+			method: http.MethodPost, path: "/api/v1/workspaces", body: `{"name":"w","runtime":"cobol:1"}`,
+			setup: func(svc *mockServices) {
+				svc.workspace.On("CreateWorkspace", mock.Anything, "test-user", mock.Anything).Return(nil, &apierrors.APIError{
+					Type: apierrors.ErrorTypeBadRequest, Code: apierrors.CodeUnsupportedRuntime, Message: "runtime not supported",
+				})
+			},
+			status: http.StatusBadRequest, code: apierrors.CodeUnsupportedRuntime,
+		},
+		{
+			name:   "not found",
+			method: http.MethodPost, path: "/api/v1/workspaces/ws-missing/activate",
+			setup: func(svc *mockServices) {
+				svc.workspace.On("ActivateWorkspace", mock.Anything, "test-user", "ws-missing").Return(
+					nil, apierrors.NewNotFoundError("workspace", "ws-missing", nil))
+			},
+			status: http.StatusNotFound, code: apierrors.CodeNotFound,
+		},
+		{
+			name:   "unexpected service error",
+			method: http.MethodPost, path: "/api/v1/workspaces/ws-1/activate",
+			setup: func(svc *mockServices) {
+				svc.workspace.On("ActivateWorkspace", mock.Anything, "test-user", "ws-1").Return(nil, errors.New("boom"))
+			},
+			status: http.StatusInternalServerError, code: apierrors.CodeInternal,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, svc := newRouterFixture(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.status, rec.Code)
+			var body apierrors.ErrorBody
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body), rec.Body.String())
+			assert.Equal(t, tc.code, body.Code)
+			assert.NotEmpty(t, body.Message)
+			assert.Equal(t, body.Message, body.Error)
+		})
+	}
+}
+
+// TestListWorkspaces_ValidationFailureCarriesFieldDetails checks the full
+// validation_error shape a client sees for a bad query parameter.
+func TestListWorkspaces_ValidationFailureCarriesFieldDetails(t *testing.T) {
+	router, svc := newRouterFixture(t)
+	svc.workspace.On("ListWorkspaces", mock.Anything, "test-user", mock.Anything).Return(nil,
+		apierrors.NewValidationError("invalid order \"up\": must be one of asc, desc",
+			map[string]interface{}{"field": "order"}, errors.New("unknown sort order")))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces?order=up", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.JSONEq(t, `{
+		"error": "invalid order \"up\": must be one of asc, desc",
+		"code": "validation_error",
+		"message": "invalid order \"up\": must be one of asc, desc",
+		"details": {"field": "order"}
+	}`, rec.Body.String())
+}
+
+// TestCreateWorkspace_BindFailureHidesDecoderText checks that a malformed
+// create body reports the generic bind message, not Go decoder output.
+func TestCreateWorkspace_BindFailureHidesDecoderText(t *testing.T) {
+	router, _ := newRouterFixture(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/workspaces", strings.NewReader(`{"name":7}`))
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	var body apierrors.ErrorBody
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body), rec.Body.String())
+	assert.Equal(t, apierrors.CodeBadRequest, body.Code)
+	assert.Equal(t, "invalid request body", body.Message)
+}
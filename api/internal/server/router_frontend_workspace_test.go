@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -553,3 +554,56 @@ func TestGetWorkspaceStatus_SessionsWithContextUsed_InJSON(t *testing.T) {
 	assert.Equal(t, int64(42000), result.Sessions[0].ContextUsed)
 	assert.Equal(t, int64(0), result.Sessions[1].ContextUsed)
 }
+
+// --- GET /api/v1/workspaces/:id/status?wait=true — long-poll ---
+
+func TestGetWorkspaceStatus_Wait_CallsLongPoll(t *testing.T) {
+	router, svc := newRouterFixture(t)
+
+	svc.workspace.On("WaitWorkspaceStatus", mock.Anything, "test-user", "ws-1", 15*time.Second).Return(
+		&types.WorkspaceStatusResult{Phase: "Active"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/ws-1/status?wait=true&timeout=15s", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"phase":"Active"`)
+	svc.workspace.AssertNotCalled(t, "GetWorkspaceStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetWorkspaceStatus_Wait_BareSecondsAndDefault(t *testing.T) {
+	router, svc := newRouterFixture(t)
+
+	svc.workspace.On("WaitWorkspaceStatus", mock.Anything, "test-user", "ws-1", 20*time.Second).Return(
+		&types.WorkspaceStatusResult{Phase: "Creating"}, nil).Once()
+	svc.workspace.On("WaitWorkspaceStatus", mock.Anything, "test-user", "ws-1", time.Duration(0)).Return(
+		&types.WorkspaceStatusResult{Phase: "Creating"}, nil).Once()
+
+	for _, url := range []string{
+		"/api/v1/workspaces/ws-1/status?wait=true&timeout=20",
+		"/api/v1/workspaces/ws-1/status?wait=true",
+	} {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("Authorization", "Bearer token")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, url)
+	}
+	svc.workspace.AssertExpectations(t)
+}
+
+func TestGetWorkspaceStatus_Wait_InvalidTimeout_Returns422(t *testing.T) {
+	router, svc := newRouterFixture(t)
+
+	for _, v := range []string{"soon", "-5s", "0"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/ws-1/status?wait=true&timeout="+v, nil)
+		req.Header.Set("Authorization", "Bearer token")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, v)
+		assert.Contains(t, rec.Body.String(), `"code":"validation_error"`, v)
+	}
+	svc.workspace.AssertNotCalled(t, "WaitWorkspaceStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsSnapshotRoute_ReturnsJSON(t *testing.T) {
+	t.Setenv("LLMSAFESPACES_METRICS_TOKEN", "")
+	router, _ := newRouterFixture(t)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics/snapshot", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	for _, k := range []string{"timestamp", "requestsTotal", "errorsTotal", "activeConnections", "workspacesCreatedTotal"} {
+		assert.Contains(t, body, k)
+	}
+}
+
+func TestMetricsSnapshotRoute_RequiresTokenWhenConfigured(t *testing.T) {
+	t.Setenv("LLMSAFESPACES_METRICS_TOKEN", "s3cret")
+	router, _ := newRouterFixture(t)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics/snapshot", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics/snapshot", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
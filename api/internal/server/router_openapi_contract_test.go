@@ -265,6 +265,9 @@ var implOnlyAllowlist = map[route]bool{
 	// /metrics is a Prometheus scrape endpoint exposed for the
 	// in-cluster monitoring stack. Not part of the public API.
 	{method: "GET", path: "/metrics"}: true,
+	// JSON summary of the same counters, for reports. Same audience
+	// and token guard as /metrics.
+	{method: "GET", path: "/metrics/snapshot"}: true,
 
 	// /health (no prefix) — legacy alias for /livez. Documented in
 	// OpenAPI under the root path. The router also serves an
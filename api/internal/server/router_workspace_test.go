@@ -189,3 +189,19 @@ func TestRefreshComputeRoute_ServiceError_Propagated(t *testing.T) {
 	assert.NotEqual(t, http.StatusAccepted, w.Code)
 	assert.NotEqual(t, http.StatusNotFound, w.Code)
 }
+
+// TestBulkDeleteRoute_PassesSelector verifies DELETE /workspaces forwards
+// the labelSelector query param and returns the summary as JSON.
+func TestBulkDeleteRoute_PassesSelector(t *testing.T) {
+	router, svc := newRouterFixture(t)
+	svc.workspace.On("DeleteWorkspacesByLabel", mock.Anything, "test-user", "batch=nightly").
+		Return(&types.BulkDeleteWorkspacesResult{Deleted: []string{"ws-1"}, Skipped: []string{}, Errors: []types.BulkDeleteWorkspaceError{}}, nil)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/workspaces?labelSelector=batch%3Dnightly", nil)
+	req.Header.Set("Authorization", "Bearer testtoken")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"deleted":["ws-1"]`)
+}
@@ -1280,7 +1280,7 @@ func (s *Service) AuthMiddleware() gin.HandlerFunc {
 		// Extract token from request
 		tokenString := s.extractToken(c)
 		if tokenString == "" {
-			c.JSON(401, gin.H{"error": "Authorization token required"})
+			apierrors.RespondStatus(c, 401, "Authorization token required")
 			c.Abort()
 			return
 		}
@@ -1291,7 +1291,7 @@ func (s *Service) AuthMiddleware() gin.HandlerFunc {
 		// (no JWT) and signingKeyByIndex returns nil.
 		userID, matchedIdx, err := s.validateTokenAndMatchedKey(c.Request.Context(), tokenString, c.ClientIP())
 		if err != nil {
-			c.JSON(401, gin.H{"error": "Invalid or expired token"})
+			apierrors.RespondStatus(c, 401, "Invalid or expired token")
 			c.Abort()
 			return
 		}
@@ -1349,21 +1349,21 @@ func (s *Service) AuthMiddleware() gin.HandlerFunc {
 			user, gerr := s.dbService.GetUser(c.Request.Context(), userID)
 			if gerr != nil {
 				if s.isUserSuspendedCached(c.Request.Context(), userID) {
-					c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "account suspended"})
+					apierrors.RespondStatus(c, http.StatusUnauthorized, "account suspended")
 					return
 				}
-				c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "unable to verify account status"})
+				apierrors.RespondStatus(c, http.StatusServiceUnavailable, "unable to verify account status")
 				return
 			}
 			if user == nil {
 				// Token validated but no user row — the account was deleted
 				// while the token was still cryptographically valid. Fail
 				// closed rather than honoring a stale credential.
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "account not found"})
+				apierrors.RespondStatus(c, http.StatusUnauthorized, "account not found")
 				return
 			}
 			if user.Status == types.UserStatusSuspended {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "account suspended"})
+				apierrors.RespondStatus(c, http.StatusUnauthorized, "account suspended")
 				return
 			}
 			// Active user. Clear any stale revocation marker (an unsuspend
@@ -670,6 +670,9 @@ func (m *apiKeyAwareDB) DeleteWorkspace(context.Context, string) error { return
 func (m *apiKeyAwareDB) ListWorkspaces(context.Context, string, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
 	return nil, nil, nil
 }
+func (m *apiKeyAwareDB) ListWorkspacesFiltered(context.Context, string, types.WorkspaceListFilter, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	return nil, nil, nil
+}
 func (m *apiKeyAwareDB) CountWorkspacesByUserAndOrg(context.Context, string, string) (int, error) {
 	return 0, nil
 }
@@ -251,6 +251,9 @@ func (m *fullMockDB) DeleteWorkspace(context.Context, string) error { return nil
 func (m *fullMockDB) ListWorkspaces(context.Context, string, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
 	return nil, nil, nil
 }
+func (m *fullMockDB) ListWorkspacesFiltered(context.Context, string, types.WorkspaceListFilter, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	return nil, nil, nil
+}
 func (m *fullMockDB) CountWorkspacesByUserAndOrg(context.Context, string, string) (int, error) {
 	return 0, nil
 }
@@ -134,6 +134,9 @@ func (m *mockDB) DeleteWorkspace(context.Context, string) error { return nil }
 func (m *mockDB) ListWorkspaces(context.Context, string, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
 	return nil, nil, nil
 }
+func (m *mockDB) ListWorkspacesFiltered(context.Context, string, types.WorkspaceListFilter, int, int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	return nil, nil, nil
+}
 func (m *mockDB) CountWorkspacesByUserAndOrg(context.Context, string, string) (int, error) {
 	return 0, nil
 }
@@ -0,0 +1,150 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// MaxImportUsers caps one import batch. Every record costs a bcrypt hash
+// plus key initialisation, so larger onboarding runs are split by the
+// caller.
+const MaxImportUsers = 200
+
+// ImportUsers creates the given users on behalf of a platform admin.
+//
+// Each record is validated and applied independently: a bad record is
+// reported in its result and the rest of the batch continues. A record is
+// all-or-nothing — if key initialisation fails after the row is inserted,
+// the row is deleted again so no half-initialised user (row without DEK)
+// is left behind. Imported users are marked email-verified because the
+// admin vouches for the address.
+//
+// Only an empty or oversized batch fails the call as a whole.
+func (s *Service) ImportUsers(ctx context.Context, records []types.ImportUserRecord) (*types.ImportUsersResult, error) {
+	if len(records) == 0 {
+		return nil, apierrors.NewValidationError("users must not be empty",
+			map[string]interface{}{"field": "users"}, nil)
+	}
+	if len(records) > MaxImportUsers {
+		return nil, apierrors.NewValidationError(
+			fmt.Sprintf("at most %d users may be imported per request", MaxImportUsers),
+			map[string]interface{}{"field": "users", "max": MaxImportUsers}, nil)
+	}
+
+	result := &types.ImportUsersResult{Results: make([]types.ImportUserResult, 0, len(records))}
+	seen := make(map[string]bool, len(records))
+	for i, rec := range records {
+		res := s.importUser(ctx, i, rec, seen)
+		if res.Status == types.ImportUserCreated {
+			result.Created++
+		} else {
+			result.Failed++
+		}
+		result.Results = append(result.Results, res)
+	}
+	return result, nil
+}
+
+func (s *Service) importUser(ctx context.Context, index int, rec types.ImportUserRecord, seen map[string]bool) types.ImportUserResult {
+	email := strings.ToLower(strings.TrimSpace(rec.Email))
+	res := types.ImportUserResult{Index: index, Email: email, Status: types.ImportUserFailed}
+
+	role, err := validateImportRecord(rec, email)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	if seen[email] {
+		res.Error = "duplicate email in batch"
+		return res
+	}
+	seen[email] = true
+
+	existing, err := s.dbService.GetUserByEmail(ctx, email)
+	if err != nil {
+		s.logger.Error("ImportUsers: failed to check existing user", err, "index", index)
+		res.Error = "failed to check existing user"
+		return res
+	}
+	if existing != nil {
+		res.Error = "email already registered"
+		return res
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(rec.Password), bcryptCost)
+	if err != nil {
+		res.Error = "failed to hash password"
+		return res
+	}
+	user := &types.User{
+		ID:           uuid.New().String(),
+		Username:     strings.TrimSpace(rec.Username),
+		Email:        email,
+		PasswordHash: string(hash),
+		Active:       true,
+		Role:         role,
+	}
+	if err := s.dbService.CreateUser(ctx, user); err != nil {
+		s.logger.Error("ImportUsers: failed to create user", err, "index", index)
+		res.Error = "failed to create user"
+		return res
+	}
+
+	if s.keyService != nil {
+		recoveryKey, err := s.keyService.InitializeUserKeys(ctx, user.ID, []byte(rec.Password))
+		if err != nil {
+			s.logger.Error("ImportUsers: failed to initialize user keys", err, "user_id", user.ID)
+			if derr := s.dbService.DeleteUser(ctx, user.ID); derr != nil {
+				s.logger.Error("ImportUsers: failed to roll back user", derr, "user_id", user.ID)
+			}
+			res.Error = "failed to initialize user keys"
+			return res
+		}
+		res.RecoveryKey = recoveryKey
+	}
+
+	verified := true
+	if err := s.dbService.UpdateUser(ctx, user.ID, types.UserUpdates{EmailVerified: &verified}); err != nil {
+		s.logger.Warn("ImportUsers: failed to persist email_verified", "user_id", user.ID, "error", err.Error())
+	}
+
+	res.Status = types.ImportUserCreated
+	res.UserID = user.ID
+	res.Role = user.Role
+	return res
+}
+
+// validateImportRecord applies the RegisterRequest binding rules to an
+// import record and resolves its role.
+func validateImportRecord(rec types.ImportUserRecord, email string) (string, error) {
+	if n := len(strings.TrimSpace(rec.Username)); n < 3 || n > 64 {
+		return "", fmt.Errorf("username must be 3-64 characters")
+	}
+	if email == "" {
+		return "", fmt.Errorf("email is required")
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return "", fmt.Errorf("invalid email address")
+	}
+	if n := len(rec.Password); n < 8 || n > 128 {
+		return "", fmt.Errorf("password must be 8-128 characters")
+	}
+	switch rec.Role {
+	case "", "user":
+		return "user", nil
+	case "admin":
+		return "admin", nil
+	}
+	return "", fmt.Errorf("role must be one of user, admin")
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func TestImportUsers_MixedBatchReportsPerRecord(t *testing.T) {
+	svc, mockDb, _ := newTestService(t)
+	ks := &fakeKeyService{recoveryKey: "rk-1"}
+	svc.SetKeyService(ks)
+	ctx := context.Background()
+
+	mockDb.On("GetUserByEmail", ctx, "alice@example.com").Return(nil, nil).Once()
+	mockDb.On("GetUserByEmail", ctx, "bob@example.com").Return(nil, nil).Once()
+	mockDb.On("GetUserByEmail", ctx, "taken@example.com").Return(&types.User{ID: "u-old"}, nil).Once()
+	mockDb.On("CreateUser", ctx, mock.MatchedBy(func(u *types.User) bool {
+		return u.Email == "alice@example.com" && u.Role == "user" && u.PasswordHash != "" && u.Active
+	})).Return(nil).Once()
+	mockDb.On("CreateUser", ctx, mock.MatchedBy(func(u *types.User) bool {
+		return u.Email == "bob@example.com" && u.Role == "admin"
+	})).Return(nil).Once()
+	mockDb.On("UpdateUser", ctx, mock.Anything, mock.MatchedBy(func(u types.UserUpdates) bool {
+		return u.EmailVerified != nil && *u.EmailVerified
+	})).Return(nil).Twice()
+
+	res, err := svc.ImportUsers(ctx, []types.ImportUserRecord{
+		{Username: "alice", Email: " Alice@Example.com ", Password: "password123"},
+		{Username: "nomail", Email: "not-an-email", Password: "password123"},
+		{Username: "shorty", Email: "short@example.com", Password: "pw"},
+		{Username: "alice2", Email: "alice@example.com", Password: "password123"},
+		{Username: "taken", Email: "taken@example.com", Password: "password123"},
+		{Username: "root", Email: "root@example.com", Password: "password123", Role: "superuser"},
+		{Username: "bob", Email: "bob@example.com", Password: "password123", Role: "admin"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, res.Created)
+	assert.Equal(t, 5, res.Failed)
+	require.Len(t, res.Results, 7)
+	for i, r := range res.Results {
+		assert.Equal(t, i, r.Index, "results stay in request order")
+	}
+
+	assert.Equal(t, types.ImportUserCreated, res.Results[0].Status)
+	assert.Equal(t, "alice@example.com", res.Results[0].Email)
+	assert.NotEmpty(t, res.Results[0].UserID)
+	assert.Equal(t, "rk-1", res.Results[0].RecoveryKey)
+
+	wantErrs := map[int]string{
+		1: "invalid email address",
+		2: "password must be 8-128 characters",
+		3: "duplicate email in batch",
+		4: "email already registered",
+		5: "role must be one of user, admin",
+	}
+	for i, msg := range wantErrs {
+		assert.Equal(t, types.ImportUserFailed, res.Results[i].Status, "record %d", i)
+		assert.Equal(t, msg, res.Results[i].Error, "record %d", i)
+		assert.Empty(t, res.Results[i].UserID, "record %d", i)
+	}
+
+	assert.Equal(t, types.ImportUserCreated, res.Results[6].Status)
+	assert.Equal(t, "admin", res.Results[6].Role)
+	assert.Len(t, ks.initCalls, 2)
+	mockDb.AssertExpectations(t)
+}
+
+func TestImportUsers_KeyInitFailureRollsBackRecord(t *testing.T) {
+	svc, mockDb, _ := newTestService(t)
+	svc.SetKeyService(&fakeKeyService{initErr: errors.New("kms down")})
+	ctx := context.Background()
+
+	var createdID string
+	mockDb.On("GetUserByEmail", ctx, "carol@example.com").Return(nil, nil).Once()
+	mockDb.On("CreateUser", ctx, mock.MatchedBy(func(u *types.User) bool {
+		createdID = u.ID
+		return true
+	})).Return(nil).Once()
+	mockDb.On("DeleteUser", ctx, mock.MatchedBy(func(id string) bool { return id == createdID })).Return(nil).Once()
+
+	res, err := svc.ImportUsers(ctx, []types.ImportUserRecord{
+		{Username: "carol", Email: "carol@example.com", Password: "password123"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, res.Created)
+	assert.Equal(t, 1, res.Failed)
+	assert.Equal(t, "failed to initialize user keys", res.Results[0].Error)
+	mockDb.AssertExpectations(t)
+	mockDb.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportUsers_RejectsEmptyAndOversizedBatches(t *testing.T) {
+	svc, _, _ := newTestService(t)
+
+	for _, records := range [][]types.ImportUserRecord{nil, make([]types.ImportUserRecord, MaxImportUsers+1)} {
+		_, err := svc.ImportUsers(context.Background(), records)
+		var apiErr *apierrors.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, apierrors.CodeValidation, apiErr.Code)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// Audit retention defaults. A pass deletes at most
+// auditPruneBatchSize*auditPruneMaxBatches rows so a large backlog (first
+// enablement on a long-lived install) drains over several ticks instead of
+// holding one long transaction against audit_log.
+const (
+	DefaultAuditPruneInterval = time.Hour
+	auditPruneBatchSize       = 1000
+	auditPruneMaxBatches      = 50
+)
+
+// AuditRetentionPolicy bounds how much of audit_log is kept. Rows older
+// than MaxAge are pruned, and so are rows beyond the newest MaxCount. A
+// zero field disables that bound; both zero disables pruning entirely.
+type AuditRetentionPolicy struct {
+	MaxAge   time.Duration
+	MaxCount int
+}
+
+// Enabled reports whether the policy prunes anything.
+func (p AuditRetentionPolicy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxCount > 0
+}
+
+// auditRetentionStore is the data-access surface the pruner needs.
+// PgOrgStore implements it.
+type auditRetentionStore interface {
+	ListAuditForRetention(ctx context.Context, before time.Time, keepNewest, limit int) ([]*types.AuditEntry, error)
+	DeleteAuditEntries(ctx context.Context, ids []int64) (int64, error)
+}
+
+// AuditArchiver receives each batch of audit entries before it is
+// deleted. An Archive error aborts the pass so no row is ever deleted
+// without having been archived.
+type AuditArchiver interface {
+	Archive(ctx context.Context, entries []*types.AuditEntry) error
+}
+
+// AuditRetentionPruner periodically prunes audit_log according to an
+// AuditRetentionPolicy, optionally exporting each batch to an
+// AuditArchiver first. Mirrors secrets.JWTSessionJanitor: a failed tick is
+// logged and retried on the next one.
+type AuditRetentionPruner struct {
+	store    auditRetentionStore
+	archiver AuditArchiver
+	policy   AuditRetentionPolicy
+	interval time.Duration
+	logger   pkginterfaces.LoggerInterface
+	now      func() time.Time
+}
+
+// NewAuditRetentionPruner builds a pruner. Pass interval=0 to use
+// DefaultAuditPruneInterval and archiver=nil to prune without archiving.
+func NewAuditRetentionPruner(store auditRetentionStore, archiver AuditArchiver, policy AuditRetentionPolicy, interval time.Duration, logger pkginterfaces.LoggerInterface) *AuditRetentionPruner {
+	if interval <= 0 {
+		interval = DefaultAuditPruneInterval
+	}
+	return &AuditRetentionPruner{
+		store:    store,
+		archiver: archiver,
+		policy:   policy,
+		interval: interval,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Run blocks until ctx is canceled, pruning once at startup and then on
+// each tick, so a pod restarted more often than the interval still prunes.
+func (p *AuditRetentionPruner) Run(ctx context.Context) {
+	p.runOnce(ctx)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce performs one pruning pass and returns the rows deleted; Run
+// calls it at startup and on each tick.
+func (p *AuditRetentionPruner) runOnce(ctx context.Context) int64 {
+	if !p.policy.Enabled() {
+		return 0
+	}
+	var before time.Time
+	if p.policy.MaxAge > 0 {
+		before = p.now().Add(-p.policy.MaxAge)
+	}
+
+	var total int64
+	for i := 0; i < auditPruneMaxBatches; i++ {
+		entries, err := p.store.ListAuditForRetention(ctx, before, p.policy.MaxCount, auditPruneBatchSize)
+		if err != nil {
+			p.warn("AuditRetentionPruner: list failed (will retry next tick)", err)
+			break
+		}
+		if len(entries) == 0 {
+			break
+		}
+		if p.archiver != nil {
+			if err := p.archiver.Archive(ctx, entries); err != nil {
+				p.warn("AuditRetentionPruner: archive failed; skipping prune (will retry next tick)", err)
+				break
+			}
+		}
+		ids := make([]int64, len(entries))
+		for j, e := range entries {
+			ids[j] = e.ID
+		}
+		n, err := p.store.DeleteAuditEntries(ctx, ids)
+		if err != nil {
+			p.warn("AuditRetentionPruner: delete failed (will retry next tick)", err)
+			break
+		}
+		total += n
+		if len(entries) < auditPruneBatchSize {
+			break
+		}
+	}
+	if total > 0 && p.logger != nil {
+		p.logger.Info("AuditRetentionPruner: pruned audit_log rows", "count", total)
+	}
+	return total
+}
+
+func (p *AuditRetentionPruner) warn(msg string, err error) {
+	if p.logger != nil {
+		p.logger.Warn(msg, "error", err.Error())
+	}
+}
+
+// FileAuditArchiver writes each archived batch as a JSON-lines file under
+// Dir. Point Dir at an object-storage mount (S3/GCS CSI driver, NFS
+// gateway) for long-term archival outside the database.
+type FileAuditArchiver struct {
+	Dir string
+}
+
+// Archive writes entries to <Dir>/audit-<first>-<last>.jsonl. The write
+// goes to a temp file renamed into place so a crash never leaves a
+// truncated archive that looks complete.
+func (a *FileAuditArchiver) Archive(_ context.Context, entries []*types.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(a.Dir, 0o750); err != nil {
+		return fmt.Errorf("create audit archive dir: %w", err)
+	}
+	name := fmt.Sprintf("audit-%d-%d.jsonl", entries[0].ID, entries[len(entries)-1].ID)
+	tmp, err := os.CreateTemp(a.Dir, name+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create audit archive: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	enc := json.NewEncoder(tmp)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			_ = tmp.Close()
+			return fmt.Errorf("encode audit entry %d: %w", e.ID, err)
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close audit archive: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(a.Dir, name)); err != nil {
+		return fmt.Errorf("finalize audit archive: %w", err)
+	}
+	return nil
+}
+
+// ListAuditForRetention returns up to limit audit_log rows eligible for
+// pruning, oldest first: rows created before `before` (ignored when zero)
+// and rows outside the newest keepNewest (ignored when <= 0).
+func (s *PgOrgStore) ListAuditForRetention(ctx context.Context, before time.Time, keepNewest, limit int) ([]*types.AuditEntry, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if !before.IsZero() {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)+1))
+		args = append(args, before)
+	}
+	if keepNewest > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			"id NOT IN (SELECT id FROM audit_log ORDER BY created_at DESC, id DESC LIMIT $%d)", len(args)+1))
+		args = append(args, keepNewest)
+	}
+	if len(conditions) == 0 {
+		return []*types.AuditEntry{}, nil
+	}
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx,
+		fmt.Sprintf(
+			"SELECT id, actor_id, domain, action, COALESCE(target_id, ''), COALESCE(org_id::text, ''), metadata, created_at FROM audit_log WHERE %s ORDER BY created_at ASC, id ASC LIMIT $%d",
+			strings.Join(conditions, " OR "), len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list audit for retention: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	entries := []*types.AuditEntry{}
+	for rows.Next() {
+		var e types.AuditEntry
+		var metaBytes []byte
+		if err := rows.Scan(&e.ID, &e.ActorID, &e.Domain, &e.Action, &e.TargetID, &e.OrgID, &metaBytes, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan audit entry: %w", err)
+		}
+		if len(metaBytes) > 0 && string(metaBytes) != "{}" {
+			if err := json.Unmarshal(metaBytes, &e.Metadata); err != nil {
+				return nil, fmt.Errorf("unmarshal audit metadata: %w", err)
+			}
+		}
+		entries = append(entries, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit entries: %w", err)
+	}
+	return entries, nil
+}
+
+// DeleteAuditEntries deletes the audit_log rows with the given ids and
+// returns the number removed.
+func (s *PgOrgStore) DeleteAuditEntries(ctx context.Context, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res, err := s.db.ExecContext(ctx, `DELETE FROM audit_log WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("delete audit entries: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete audit entries rows affected: %w", err)
+	}
+	return n, nil
+}
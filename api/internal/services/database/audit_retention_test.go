@@ -0,0 +1,267 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package database
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// fakeAuditRetentionStore applies the same eligibility rules as the SQL in
+// ListAuditForRetention over an in-memory slice.
+type fakeAuditRetentionStore struct {
+	entries []*types.AuditEntry
+	listErr error
+	delErr  error
+}
+
+func (f *fakeAuditRetentionStore) ListAuditForRetention(_ context.Context, before time.Time, keepNewest, limit int) ([]*types.AuditEntry, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	newestFirst := append([]*types.AuditEntry(nil), f.entries...)
+	sort.Slice(newestFirst, func(i, j int) bool { return newestFirst[i].CreatedAt.After(newestFirst[j].CreatedAt) })
+	kept := map[int64]bool{}
+	if keepNewest > 0 {
+		for i := 0; i < keepNewest && i < len(newestFirst); i++ {
+			kept[newestFirst[i].ID] = true
+		}
+	}
+	var out []*types.AuditEntry
+	for i := len(newestFirst) - 1; i >= 0 && len(out) < limit; i-- {
+		e := newestFirst[i]
+		if (!before.IsZero() && e.CreatedAt.Before(before)) || (keepNewest > 0 && !kept[e.ID]) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeAuditRetentionStore) DeleteAuditEntries(_ context.Context, ids []int64) (int64, error) {
+	if f.delErr != nil {
+		return 0, f.delErr
+	}
+	drop := map[int64]bool{}
+	for _, id := range ids {
+		drop[id] = true
+	}
+	var remaining []*types.AuditEntry
+	for _, e := range f.entries {
+		if !drop[e.ID] {
+			remaining = append(remaining, e)
+		}
+	}
+	n := int64(len(f.entries) - len(remaining))
+	f.entries = remaining
+	return n, nil
+}
+
+func (f *fakeAuditRetentionStore) ids() []int64 {
+	out := make([]int64, 0, len(f.entries))
+	for _, e := range f.entries {
+		out = append(out, e.ID)
+	}
+	return out
+}
+
+type recordingArchiver struct {
+	archived []int64
+	err      error
+}
+
+func (a *recordingArchiver) Archive(_ context.Context, entries []*types.AuditEntry) error {
+	if a.err != nil {
+		return a.err
+	}
+	for _, e := range entries {
+		a.archived = append(a.archived, e.ID)
+	}
+	return nil
+}
+
+func auditEntriesAged(now time.Time, ages ...time.Duration) []*types.AuditEntry {
+	out := make([]*types.AuditEntry, len(ages))
+	for i, age := range ages {
+		out[i] = &types.AuditEntry{ID: int64(i + 1), Domain: "org", Action: "test", CreatedAt: now.Add(-age)}
+	}
+	return out
+}
+
+func TestAuditRetentionPruner_PrunesOlderThanMaxAge(t *testing.T) {
+	now := time.Now()
+	store := &fakeAuditRetentionStore{entries: auditEntriesAged(now, 100*24*time.Hour, 40*24*time.Hour, time.Hour)}
+	p := NewAuditRetentionPruner(store, nil, AuditRetentionPolicy{MaxAge: 30 * 24 * time.Hour}, 0, nil)
+	p.now = func() time.Time { return now }
+
+	n := p.runOnce(context.Background())
+	assert.Equal(t, int64(2), n)
+	assert.Equal(t, []int64{3}, store.ids())
+}
+
+// signalingArchiver closes archived on its first Archive call.
+type signalingArchiver struct {
+	once     sync.Once
+	archived chan struct{}
+}
+
+func (a *signalingArchiver) Archive(context.Context, []*types.AuditEntry) error {
+	a.once.Do(func() { close(a.archived) })
+	return nil
+}
+
+func TestAuditRetentionPruner_RunPrunesAtStartup(t *testing.T) {
+	now := time.Now()
+	store := &fakeAuditRetentionStore{entries: auditEntriesAged(now, 100*24*time.Hour, time.Hour)}
+	archiver := &signalingArchiver{archived: make(chan struct{})}
+	p := NewAuditRetentionPruner(store, archiver, AuditRetentionPolicy{MaxAge: 30 * 24 * time.Hour}, time.Hour, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.Run(ctx)
+	}()
+
+	select {
+	case <-archiver.archived:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run must prune before the first tick")
+	}
+	cancel()
+	<-done
+	assert.Equal(t, []int64{2}, store.ids())
+}
+
+func TestAuditRetentionPruner_PrunesBeyondMaxCount(t *testing.T) {
+	now := time.Now()
+	store := &fakeAuditRetentionStore{entries: auditEntriesAged(now, 4*time.Hour, 3*time.Hour, 2*time.Hour, time.Hour)}
+	p := NewAuditRetentionPruner(store, nil, AuditRetentionPolicy{MaxCount: 2}, 0, nil)
+
+	n := p.runOnce(context.Background())
+	assert.Equal(t, int64(2), n)
+	assert.ElementsMatch(t, []int64{3, 4}, store.ids(), "newest MaxCount rows must be kept")
+}
+
+func TestAuditRetentionPruner_ArchivesBeforePruning(t *testing.T) {
+	now := time.Now()
+	store := &fakeAuditRetentionStore{entries: auditEntriesAged(now, 100*24*time.Hour, 50*24*time.Hour, time.Hour)}
+	archiver := &recordingArchiver{}
+	p := NewAuditRetentionPruner(store, archiver, AuditRetentionPolicy{MaxAge: 30 * 24 * time.Hour}, 0, nil)
+	p.now = func() time.Time { return now }
+
+	n := p.runOnce(context.Background())
+	assert.Equal(t, int64(2), n)
+	assert.Equal(t, []int64{1, 2}, archiver.archived, "pruned rows archived oldest first")
+	assert.Equal(t, []int64{3}, store.ids())
+}
+
+func TestAuditRetentionPruner_ArchiveFailureKeepsRows(t *testing.T) {
+	now := time.Now()
+	store := &fakeAuditRetentionStore{entries: auditEntriesAged(now, 100*24*time.Hour)}
+	archiver := &recordingArchiver{err: errors.New("bucket unavailable")}
+	p := NewAuditRetentionPruner(store, archiver, AuditRetentionPolicy{MaxAge: 30 * 24 * time.Hour}, 0, nil)
+	p.now = func() time.Time { return now }
+
+	assert.Equal(t, int64(0), p.runOnce(context.Background()))
+	assert.Equal(t, []int64{1}, store.ids(), "rows must not be deleted when archiving fails")
+}
+
+func TestAuditRetentionPruner_DisabledPolicyIsNoop(t *testing.T) {
+	now := time.Now()
+	store := &fakeAuditRetentionStore{entries: auditEntriesAged(now, 1000*24*time.Hour)}
+	p := NewAuditRetentionPruner(store, nil, AuditRetentionPolicy{}, 0, nil)
+
+	assert.Equal(t, int64(0), p.runOnce(context.Background()))
+	assert.Len(t, store.entries, 1)
+}
+
+func TestAuditRetentionPruner_StoreErrorsAreTolerated(t *testing.T) {
+	store := &fakeAuditRetentionStore{listErr: errors.New("transient PG outage")}
+	p := NewAuditRetentionPruner(store, nil, AuditRetentionPolicy{MaxCount: 1}, 0, nil)
+	assert.Equal(t, int64(0), p.runOnce(context.Background()))
+}
+
+func TestFileAuditArchiver_WritesJSONLines(t *testing.T) {
+	dir := t.TempDir()
+	a := &FileAuditArchiver{Dir: dir}
+	entries := auditEntriesAged(time.Now(), 2*time.Hour, time.Hour)
+
+	require.NoError(t, a.Archive(context.Background(), entries))
+
+	data, err := os.ReadFile(filepath.Join(dir, "audit-1-2.jsonl"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, countLines(data))
+	leftovers, err := filepath.Glob(filepath.Join(dir, "*.tmp-*"))
+	require.NoError(t, err)
+	assert.Empty(t, leftovers, "temp file must be renamed into place")
+}
+
+func countLines(b []byte) int {
+	n := 0
+	for _, c := range b {
+		if c == '\n' {
+			n++
+		}
+	}
+	return n
+}
+
+func TestPgOrgStore_ListAuditForRetention_CombinesBounds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := NewPgOrgStore(db)
+
+	before := time.Now().Add(-24 * time.Hour)
+	created := before.Add(-time.Hour)
+	mock.ExpectQuery(`FROM audit_log WHERE created_at < \$1 OR id NOT IN \(SELECT id FROM audit_log ORDER BY created_at DESC, id DESC LIMIT \$2\) ORDER BY created_at ASC, id ASC LIMIT \$3`).
+		WithArgs(before, 10, 1000).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "actor_id", "domain", "action", "target_id", "org_id", "metadata", "created_at"}).
+			AddRow(int64(7), "u-1", "org", "member.added", "", "", []byte(`{}`), created))
+
+	entries, err := store.ListAuditForRetention(context.Background(), before, 10, 1000)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(7), entries[0].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgOrgStore_ListAuditForRetention_NoBoundsSkipsQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := NewPgOrgStore(db)
+
+	entries, err := store.ListAuditForRetention(context.Background(), time.Time{}, 0, 1000)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgOrgStore_DeleteAuditEntries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	store := NewPgOrgStore(db)
+
+	mock.ExpectExec(`DELETE FROM audit_log WHERE id = ANY\(\$1\)`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	n, err := store.DeleteAuditEntries(context.Background(), []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -686,6 +686,30 @@ func (s *Service) MarkWorkspaceDeleted(ctx context.Context, workspaceID string)
 // workspaces use the dedicated GET /orgs/:id/workspaces endpoint
 // (OrgStore.ListOrgWorkspaces).
 func (s *Service) ListWorkspaces(ctx context.Context, userID string, limit, offset int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	return s.ListWorkspacesFiltered(ctx, userID, types.WorkspaceListFilter{}, limit, offset)
+}
+
+// workspaceSortColumns maps the accepted sort fields to their columns. Only
+// these values are ever interpolated into ORDER BY.
+var workspaceSortColumns = map[string]string{
+	"":                           "w.created_at",
+	types.WorkspaceSortCreatedAt: "w.created_at",
+	types.WorkspaceSortRuntime:   "w.runtime",
+}
+
+// ListWorkspacesFiltered is ListWorkspaces narrowed to filter.IDs and
+// filter.Runtime and ordered by filter.SortBy; the zero filter lists
+// newest first. Ties are broken by id so pages are stable.
+func (s *Service) ListWorkspacesFiltered(ctx context.Context, userID string, filter types.WorkspaceListFilter, limit, offset int) ([]*types.WorkspaceMetadata, *types.PaginationMetadata, error) {
+	sortColumn, ok := workspaceSortColumns[filter.SortBy]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported workspace sort field: %s", filter.SortBy)
+	}
+	direction := "DESC"
+	if filter.Ascending {
+		direction = "ASC"
+	}
+
 	// S1: filter out frozen workspaces — org-attributed workspaces where the
 	// user is no longer a current member of the org (offboarded or org
 	// soft-deleted). Personal workspaces (org_id IS NULL) are always shown.
@@ -702,11 +726,22 @@ func (s *Service) ListWorkspaces(ctx context.Context, userID string, limit, offs
             )
         )`
 
+	where := membershipCondition
+	args := []interface{}{userID}
+	if filter.IDs != nil {
+		args = append(args, pq.Array(filter.IDs))
+		where += fmt.Sprintf(" AND w.id = ANY($%d)", len(args))
+	}
+	if filter.Runtime != "" {
+		args = append(args, filter.Runtime)
+		where += fmt.Sprintf(" AND w.runtime = $%d", len(args))
+	}
+
 	var total int
 	if err := s.DB.QueryRowContext(ctx, `
 		SELECT COUNT(*) FROM workspaces w
-		WHERE w.deleted_at IS NULL AND w.user_id = $1`+membershipCondition,
-		userID,
+		WHERE w.deleted_at IS NULL AND w.user_id = $1`+where,
+		args...,
 	).Scan(&total); err != nil {
 		return nil, nil, fmt.Errorf("failed to count workspaces: %w", err)
 	}
@@ -731,10 +766,10 @@ func (s *Service) ListWorkspaces(ctx context.Context, userID string, limit, offs
                w.org_id
         FROM workspaces w
         LEFT JOIN workspace_agent_state s ON s.workspace_id = w.id
-        WHERE w.deleted_at IS NULL AND w.user_id = $1`+membershipCondition+`
-        ORDER BY w.created_at DESC
-        LIMIT $2 OFFSET $3
-    `, userID, limit, offset)
+        WHERE w.deleted_at IS NULL AND w.user_id = $1`+where+fmt.Sprintf(`
+        ORDER BY %[1]s %[2]s, w.id %[2]s
+        LIMIT $%[3]d OFFSET $%[4]d
+    `, sortColumn, direction, len(args)+1, len(args)+2), append(args, limit, offset)...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to list workspaces: %w", err)
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/lenaxia/llmsafespaces/api/internal/config"
 	"github.com/lenaxia/llmsafespaces/api/internal/logger"
 	"github.com/lenaxia/llmsafespaces/pkg/types"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -600,6 +601,56 @@ func TestListWorkspaces(t *testing.T) {
 	})
 }
 
+func TestListWorkspacesFiltered(t *testing.T) {
+	t.Run("runtime_and_ids_ascending_by_runtime", func(t *testing.T) {
+		service, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctx := context.Background()
+		ids := []string{"ws-1", "ws-2"}
+		filter := types.WorkspaceListFilter{IDs: ids, Runtime: "python:3.11", SortBy: types.WorkspaceSortRuntime, Ascending: true}
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM workspaces w.*AND w.id = ANY\(\$2\) AND w.runtime = \$3`).
+			WithArgs("user-1", pq.Array(ids), "python:3.11").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`SELECT w.id, .*AND w.id = ANY\(\$2\) AND w.runtime = \$3\s+ORDER BY w.runtime ASC, w.id ASC\s+LIMIT \$4 OFFSET \$5`).
+			WithArgs("user-1", pq.Array(ids), "python:3.11", 10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "runtime", "storage_size", "image_tag", "agent_version", "created_at", "updated_at", "default_model", "agent_needs_refresh", "credentials_pending_since", "org_id"}).
+				AddRow("ws-1", "user-1", "One", "python:3.11", "5Gi", "", "", time.Now(), time.Now(), "", false, nil, nil))
+
+		wsList, pagination, err := service.ListWorkspacesFiltered(ctx, "user-1", filter, 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, wsList, 1)
+		assert.Equal(t, 1, pagination.Total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("default_is_newest_first", func(t *testing.T) {
+		service, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM workspaces w`).
+			WithArgs("user-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`ORDER BY w.created_at DESC, w.id DESC\s+LIMIT \$2 OFFSET \$3`).
+			WithArgs("user-1", 10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "runtime", "storage_size", "image_tag", "agent_version", "created_at", "updated_at", "default_model", "agent_needs_refresh", "credentials_pending_since", "org_id"}))
+
+		_, _, err := service.ListWorkspacesFiltered(context.Background(), "user-1", types.WorkspaceListFilter{}, 10, 0)
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown_sort_field", func(t *testing.T) {
+		service, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		_, _, err := service.ListWorkspacesFiltered(context.Background(), "user-1", types.WorkspaceListFilter{SortBy: "name"}, 10, 0)
+		assert.ErrorContains(t, err, "unsupported workspace sort field")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestUpdateWorkspace(t *testing.T) {
 	t.Run("name_updated", func(t *testing.T) {
 		service, mock, cleanup := setupMockDB(t)
@@ -28,7 +28,7 @@ const (
 // compat) and errors.As (typed path).
 var ErrTooManySubscribers = &apierrors.APIError{
 	Type:    apierrors.ErrorTypeRateLimit,
-	Code:    "too_many_subscribers",
+	Code:    apierrors.CodeTooManySubscribers,
 	Message: "too many active SSE subscribers for user",
 }
 
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// Snapshot summarizes the key API metrics from g in one Gather call. Cost
+// is linear in the number of series, the same as a /metrics scrape.
+// Families that have not been registered or observed yet read as zero.
+func Snapshot(g prometheus.Gatherer, now time.Time) (*types.MetricsSnapshot, error) {
+	families, err := g.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("gather metrics: %w", err)
+	}
+	byName := make(map[string]*dto.MetricFamily, len(families))
+	for _, mf := range families {
+		byName[mf.GetName()] = mf
+	}
+
+	snap := &types.MetricsSnapshot{
+		Timestamp:                  now.UTC(),
+		RequestsTotal:              sumFamily(byName["api_requests_total"]),
+		ErrorsTotal:                sumFamily(byName["api_errors_total"]),
+		ActiveConnections:          sumFamily(byName["api_active_connections"]),
+		WorkspacesCreatedTotal:     sumFamily(byName["workspaces_created_total"]),
+		WorkspacesTerminatedTotal:  sumFamily(byName["workspaces_terminated_total"]),
+		InferenceRequestsTotal:     sumFamily(byName["llmsafespaces_inference_requests_total"]),
+		InferenceInputTokensTotal:  sumFamily(byName["llmsafespaces_inference_input_tokens_total"]),
+		InferenceOutputTokensTotal: sumFamily(byName["llmsafespaces_inference_output_tokens_total"]),
+		InferenceCostDollarsTotal:  sumFamily(byName["llmsafespaces_inference_cost_dollars_total"]),
+	}
+	if sum, count := histogramTotals(byName["api_request_duration_seconds"]); count > 0 {
+		snap.RequestDurationAvgSeconds = sum / float64(count)
+	}
+	return snap, nil
+}
+
+// sumFamily adds up every counter or gauge series in mf.
+func sumFamily(mf *dto.MetricFamily) float64 {
+	var total float64
+	for _, m := range mf.GetMetric() {
+		switch {
+		case m.Counter != nil:
+			total += m.GetCounter().GetValue()
+		case m.Gauge != nil:
+			total += m.GetGauge().GetValue()
+		}
+	}
+	return total
+}
+
+// histogramTotals returns the summed sample sum and count of every series
+// in a histogram family.
+func histogramTotals(mf *dto.MetricFamily) (sum float64, count uint64) {
+	for _, m := range mf.GetMetric() {
+		if h := m.GetHistogram(); h != nil {
+			sum += h.GetSampleSum()
+			count += h.GetSampleCount()
+		}
+	}
+	return sum, count
+}
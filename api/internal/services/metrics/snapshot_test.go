@@ -0,0 +1,54 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot_SumsSeededMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "api_requests_total"}, []string{"status"})
+	errs := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "api_errors_total"}, []string{"type"})
+	conns := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "api_active_connections"}, []string{"type"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "api_request_duration_seconds"}, []string{"method"})
+	created := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "workspaces_created_total"}, []string{"runtime"})
+	tokens := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "llmsafespaces_inference_input_tokens_total"}, []string{"model"})
+	reg.MustRegister(requests, errs, conns, duration, created, tokens)
+
+	requests.WithLabelValues("200").Add(7)
+	requests.WithLabelValues("500").Add(3)
+	errs.WithLabelValues("internal").Add(3)
+	conns.WithLabelValues("sse").Set(2)
+	conns.WithLabelValues("terminal").Set(1)
+	duration.WithLabelValues("GET").Observe(0.1)
+	duration.WithLabelValues("POST").Observe(0.3)
+	created.WithLabelValues("base").Inc()
+	tokens.WithLabelValues("m1").Add(1500)
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	snap, err := Snapshot(reg, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, now, snap.Timestamp)
+	assert.Equal(t, 10.0, snap.RequestsTotal)
+	assert.Equal(t, 3.0, snap.ErrorsTotal)
+	assert.Equal(t, 3.0, snap.ActiveConnections)
+	assert.InDelta(t, 0.2, snap.RequestDurationAvgSeconds, 1e-9)
+	assert.Equal(t, 1.0, snap.WorkspacesCreatedTotal)
+	assert.Equal(t, 1500.0, snap.InferenceInputTokensTotal)
+	assert.Zero(t, snap.WorkspacesTerminatedTotal, "unregistered families read as zero")
+}
+
+func TestSnapshot_EmptyRegistry(t *testing.T) {
+	snap, err := Snapshot(prometheus.NewRegistry(), time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, snap.RequestsTotal)
+	assert.Zero(t, snap.RequestDurationAvgSeconds)
+}
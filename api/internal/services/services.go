@@ -77,7 +77,9 @@ func New(cfg *config.Config, log *logger.Logger, k8sClient interfaces.Kubernetes
 	}
 
 	workspaceConfig := &workspace.Config{
-		Namespace: cfg.Kubernetes.Namespace,
+		Namespace:          cfg.Kubernetes.Namespace,
+		RuntimeCacheResync: cfg.Workspace.RuntimeCacheResync,
+		CacheTTL:           cfg.Workspace.CacheTTL,
 	}
 
 	workspaceService, err := workspace.New(
@@ -0,0 +1,101 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// DeleteWorkspacesByLabel deletes every workspace of userID whose CRD
+// matches selector. The CRD list is always narrowed to the caller's own
+// user-id label, so another user's workspaces are never listed, let alone
+// reported. Each match still goes through the per-workspace ownership
+// check; matches that fail it (e.g. an org workspace after the user left
+// the org) are reported as skipped rather than failing the whole call.
+func (s *Service) DeleteWorkspacesByLabel(ctx context.Context, userID, selector string) (*types.BulkDeleteWorkspacesResult, error) {
+	start := time.Now()
+	defer func() {
+		if s.metricsService != nil {
+			s.metricsService.RecordRequest("DeleteWorkspacesByLabel", "", 0, time.Since(start), 0)
+		}
+	}()
+
+	sel, err := ownedSelector(userID, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := func() (*v1.WorkspaceList, error) {
+		wsClient, wErr := s.workspaceCRDClient()
+		if wErr != nil {
+			return nil, wErr
+		}
+		return wsClient.List(ctx, metav1.ListOptions{LabelSelector: sel})
+	}()
+	if err != nil {
+		s.logger.Error("Failed to list workspaces for bulk delete", err, "userID", userID, "selector", selector)
+		return nil, apierrors.NewInternalError("workspace_bulk_delete_failed", err)
+	}
+
+	result := &types.BulkDeleteWorkspacesResult{
+		Deleted: []string{},
+		Skipped: []string{},
+		Errors:  []types.BulkDeleteWorkspaceError{},
+	}
+	for i := range list.Items {
+		id := list.Items[i].Name
+		if err := s.verifyOwner(ctx, userID, id); err != nil {
+			var apiErr *apierrors.APIError
+			if errors.As(err, &apiErr) && (apiErr.Type == apierrors.ErrorTypeForbidden || apiErr.Type == apierrors.ErrorTypeNotFound) {
+				result.Skipped = append(result.Skipped, id)
+				continue
+			}
+			result.Errors = append(result.Errors, types.BulkDeleteWorkspaceError{ID: id, Error: err.Error()})
+			continue
+		}
+		if err := s.deleteOwnedWorkspace(ctx, userID, id); err != nil {
+			result.Errors = append(result.Errors, types.BulkDeleteWorkspaceError{ID: id, Error: err.Error()})
+			continue
+		}
+		result.Deleted = append(result.Deleted, id)
+	}
+	return result, nil
+}
+
+// ownedSelector parses a user-supplied label selector and ANDs it with the
+// caller's user-id label. An empty selector is rejected: it would match
+// every workspace the user has.
+func ownedSelector(userID, selector string) (string, error) {
+	if selector == "" {
+		return "", apierrors.NewValidationError(
+			"labelSelector is required",
+			map[string]interface{}{"field": "labelSelector"},
+			fmt.Errorf("empty label selector"),
+		)
+	}
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return "", apierrors.NewValidationError(
+			fmt.Sprintf("invalid labelSelector: %v", err),
+			map[string]interface{}{"field": "labelSelector"},
+			err,
+		)
+	}
+	owner, err := labels.NewRequirement("user-id", selection.Equals, []string{userID})
+	if err != nil {
+		return "", apierrors.NewInternalError("workspace_bulk_delete_failed", err)
+	}
+	return parsed.Add(*owner).String(), nil
+}
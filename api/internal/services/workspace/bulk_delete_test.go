@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func TestDeleteWorkspacesByLabel_DeletesSkipsAndReportsErrors(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	f.ws.On("List", mock.Anything, mock.MatchedBy(func(o metav1.ListOptions) bool {
+		return o.LabelSelector == "batch=nightly,user-id=user1"
+	})).Return(&v1.WorkspaceList{Items: []v1.Workspace{
+		*crdWorkspace("ws-1", "default", "user1", "10Gi"),
+		*crdWorkspace("ws-2", "default", "user1", "10Gi"),
+		*crdWorkspace("ws-3", "default", "user1", "10Gi"),
+	}}, nil)
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "a", "10Gi"), nil)
+	f.db.On("GetWorkspace", ctx, "ws-2").Return((*types.WorkspaceMetadata)(nil), nil)
+	f.db.On("GetWorkspace", ctx, "ws-3").Return(dbWorkspace("ws-3", "user1", "c", "10Gi"), nil)
+	f.ws.On("Delete", mock.Anything, "ws-1", mock.Anything).Return(nil)
+	f.ws.On("Delete", mock.Anything, "ws-3", mock.Anything).Return(errors.New("etcd down"))
+	f.db.On("MarkWorkspaceDeleted", mock.Anything, mock.Anything).Maybe()
+
+	result, err := f.svc.DeleteWorkspacesByLabel(ctx, "user1", "batch=nightly")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ws-1"}, result.Deleted)
+	assert.Equal(t, []string{"ws-2"}, result.Skipped)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "ws-3", result.Errors[0].ID)
+	f.ws.AssertNotCalled(t, "Delete", mock.Anything, "ws-2", mock.Anything)
+}
+
+func TestDeleteWorkspacesByLabel_NoMatchesReturnsEmptyLists(t *testing.T) {
+	f := newFixture(t)
+	f.ws.On("List", mock.Anything, mock.Anything).Return(&v1.WorkspaceList{}, nil)
+
+	result, err := f.svc.DeleteWorkspacesByLabel(context.Background(), "user1", "batch=nightly")
+
+	require.NoError(t, err)
+	assert.NotNil(t, result.Deleted)
+	assert.NotNil(t, result.Skipped)
+	assert.NotNil(t, result.Errors)
+}
+
+func TestDeleteWorkspacesByLabel_RejectsEmptyOrInvalidSelector(t *testing.T) {
+	f := newFixture(t)
+	for _, sel := range []string{"", "batch in (", "!!"} {
+		_, err := f.svc.DeleteWorkspacesByLabel(context.Background(), "user1", sel)
+		require.Error(t, err, "selector %q", sel)
+		var apiErr *apierrors.APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, apierrors.ErrorTypeValidation, apiErr.Type, "selector %q", sel)
+	}
+	f.ws.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func TestOwnedSelector_CannotWidenPastCaller(t *testing.T) {
+	sel, err := ownedSelector("user1", "user-id=user2")
+	require.NoError(t, err)
+	assert.Contains(t, sel, "user-id=user1")
+	assert.Contains(t, sel, "user-id=user2", "both requirements are ANDed, so nothing of user2's matches")
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// ForceCleanWorkspace unblocks a Workspace whose deletion has been pending
+// for at least v1.StuckTerminatingThreshold: it force-deletes the pod
+// (grace 0) and strips every finalizer so the API server can remove the
+// CR. The PVC, password secret, service account and NetworkPolicy are
+// owned by the Workspace and are garbage-collected once it is gone.
+//
+// Admin-only; ownership is not checked. A workspace that is not being
+// deleted, or whose deletion is younger than the threshold, is a conflict
+// so force-clean never races the controller's normal cleanup.
+func (s *Service) ForceCleanWorkspace(ctx context.Context, workspaceID string) (*types.ForceCleanWorkspaceResult, error) {
+	start := time.Now()
+	defer func() {
+		if s.metricsService != nil {
+			s.metricsService.RecordRequest("ForceCleanWorkspace", "", 0, time.Since(start), 0)
+		}
+	}()
+
+	wsClient, err := s.workspaceCRDClient()
+	if err != nil {
+		return nil, apierrors.NewInternalError("workspace_get_failed", err)
+	}
+	crd, err := wsClient.Get(ctx, workspaceID, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFoundError("workspace", workspaceID, err)
+		}
+		return nil, apierrors.NewInternalError("workspace_get_failed", err)
+	}
+
+	if crd.DeletionTimestamp.IsZero() {
+		return nil, notStuckError(workspaceID, "workspace is not terminating")
+	}
+	pending := time.Since(crd.DeletionTimestamp.Time)
+	if pending < v1.StuckTerminatingThreshold {
+		return nil, notStuckError(workspaceID,
+			fmt.Sprintf("workspace has been terminating for %s; force-clean is allowed after %s",
+				pending.Round(time.Second), v1.StuckTerminatingThreshold))
+	}
+
+	result := &types.ForceCleanWorkspaceResult{
+		WorkspaceID:        workspaceID,
+		TerminatingSeconds: int64(pending.Seconds()),
+		RemovedFinalizers:  append([]string{}, crd.Finalizers...),
+	}
+
+	if crd.Status.PodName != "" {
+		grace := int64(0)
+		err := s.k8sClient.Clientset().CoreV1().Pods(crd.Namespace).Delete(ctx, crd.Status.PodName,
+			metav1.DeleteOptions{GracePeriodSeconds: &grace})
+		switch {
+		case err == nil:
+			result.PodDeleted = true
+		case !k8serrors.IsNotFound(err):
+			s.logger.Error("Failed to force-delete workspace pod", err,
+				"workspaceID", workspaceID, "pod", crd.Status.PodName)
+			return nil, apierrors.NewInternalError("workspace_force_clean_failed", err)
+		}
+	}
+
+	if len(crd.Finalizers) > 0 {
+		crd.Finalizers = nil
+		if _, err := wsClient.Update(ctx, crd); err != nil && !k8serrors.IsNotFound(err) {
+			s.logger.Error("Failed to remove workspace finalizers", err, "workspaceID", workspaceID)
+			return nil, apierrors.NewInternalError("workspace_force_clean_failed", err)
+		}
+	}
+
+	s.markDeleted(ctx, workspaceID)
+
+	s.logger.Warn("Workspace force-cleaned",
+		"workspaceID", workspaceID,
+		"terminatingSeconds", result.TerminatingSeconds,
+		"podDeleted", result.PodDeleted,
+		"removedFinalizers", result.RemovedFinalizers)
+	return result, nil
+}
+
+// notStuckError is the 409 for a force-clean the workspace does not
+// qualify for yet.
+func notStuckError(workspaceID, message string) *apierrors.APIError {
+	return &apierrors.APIError{
+		Type:    apierrors.ErrorTypeConflict,
+		Code:    apierrors.CodeConflict,
+		Message: message,
+		Details: map[string]interface{}{"workspaceId": workspaceID},
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+func terminatingCRD(age time.Duration) *v1.Workspace {
+	crd := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	ts := metav1.NewTime(time.Now().Add(-age))
+	crd.DeletionTimestamp = &ts
+	crd.Finalizers = []string{"workspace.llmsafespaces.dev/finalizer", "kubernetes.io/pvc-protection"}
+	crd.Status.Phase = v1.WorkspacePhaseTerminating
+	crd.Status.PodName = "ws-1-abcdef12"
+	return crd
+}
+
+func TestForceCleanWorkspace_StuckRemovesFinalizersAndPod(t *testing.T) {
+	f := newFixtureWithFakeClientset(t)
+	ctx := context.Background()
+	_, err := f.fakeCS.CoreV1().Pods("default").Create(ctx,
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ws-1-abcdef12", Namespace: "default"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	crd := terminatingCRD(v1.StuckTerminatingThreshold + 5*time.Minute)
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+	f.ws.On("Update", mock.Anything, mock.MatchedBy(func(ws *v1.Workspace) bool {
+		return len(ws.Finalizers) == 0
+	})).Return(crd, nil).Once()
+	f.db.On("MarkWorkspaceDeleted", mock.Anything, "ws-1").Maybe()
+
+	res, err := f.svc.ForceCleanWorkspace(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.True(t, res.PodDeleted)
+	assert.Equal(t, []string{"workspace.llmsafespaces.dev/finalizer", "kubernetes.io/pvc-protection"}, res.RemovedFinalizers)
+	assert.GreaterOrEqual(t, res.TerminatingSeconds, int64(v1.StuckTerminatingThreshold.Seconds()))
+
+	_, err = f.fakeCS.CoreV1().Pods("default").Get(ctx, "ws-1-abcdef12", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err), "pod must be force-deleted")
+	f.ws.AssertExpectations(t)
+}
+
+func TestForceCleanWorkspace_PodAlreadyGone(t *testing.T) {
+	f := newFixtureWithFakeClientset(t)
+	crd := terminatingCRD(time.Hour)
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+	f.ws.On("Update", mock.Anything, mock.Anything).Return(crd, nil).Once()
+	f.db.On("MarkWorkspaceDeleted", mock.Anything, "ws-1").Maybe()
+
+	res, err := f.svc.ForceCleanWorkspace(context.Background(), "ws-1")
+	require.NoError(t, err)
+	assert.False(t, res.PodDeleted)
+}
+
+func TestForceCleanWorkspace_RejectsNotStuck(t *testing.T) {
+	cases := map[string]*v1.Workspace{
+		"not terminating": crdWorkspace("ws-1", "default", "user1", "10Gi"),
+		"recent deletion": terminatingCRD(time.Minute),
+	}
+	for name, crd := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t)
+			f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+
+			_, err := f.svc.ForceCleanWorkspace(context.Background(), "ws-1")
+			require.Error(t, err)
+			assert.Equal(t, apierrors.ErrorTypeConflict, priorityErrorType(t, err))
+			f.ws.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestForceCleanWorkspace_NotFound(t *testing.T) {
+	f := newFixture(t)
+	f.ws.On("Get", mock.Anything, "ws-x", mock.Anything).
+		Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "llmsafespaces.dev", Resource: "workspaces"}, "ws-x"))
+
+	_, err := f.svc.ForceCleanWorkspace(context.Background(), "ws-x")
+	require.Error(t, err)
+	assert.Equal(t, apierrors.ErrorTypeNotFound, priorityErrorType(t, err))
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"fmt"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// listPhases are the phases GET /workspaces?status= accepts.
+var listPhases = map[string]bool{
+	string(v1.WorkspacePhasePending):     true,
+	string(v1.WorkspacePhaseCreating):    true,
+	string(v1.WorkspacePhaseActive):      true,
+	string(v1.WorkspacePhaseSuspending):  true,
+	string(v1.WorkspacePhaseSuspended):   true,
+	string(v1.WorkspacePhaseResuming):    true,
+	string(v1.WorkspacePhaseTerminating): true,
+	string(v1.WorkspacePhaseTerminated):  true,
+	string(v1.WorkspacePhaseFailed):      true,
+}
+
+// listFilter validates the filter and sort parameters of a workspace list
+// and converts them to the database filter. The phase filter is not part
+// of the result: phase lives on the CRD, so the caller resolves it to IDs.
+func listFilter(opts types.ListOptions) (types.WorkspaceListFilter, error) {
+	if opts.Phase != "" && !listPhases[opts.Phase] {
+		return types.WorkspaceListFilter{}, apierrors.NewValidationError(
+			fmt.Sprintf("invalid status %q: must be a workspace phase such as Active or Suspended", opts.Phase),
+			map[string]interface{}{"field": "status"},
+			fmt.Errorf("unknown phase %q", opts.Phase),
+		)
+	}
+
+	switch opts.Sort {
+	case "", types.WorkspaceSortCreatedAt, types.WorkspaceSortRuntime:
+	default:
+		return types.WorkspaceListFilter{}, apierrors.NewValidationError(
+			fmt.Sprintf("invalid sort %q: must be one of createdAt, runtime", opts.Sort),
+			map[string]interface{}{"field": "sort"},
+			fmt.Errorf("unknown sort field %q", opts.Sort),
+		)
+	}
+
+	switch opts.Order {
+	case "", types.SortOrderAsc, types.SortOrderDesc:
+	default:
+		return types.WorkspaceListFilter{}, apierrors.NewValidationError(
+			fmt.Sprintf("invalid order %q: must be one of asc, desc", opts.Order),
+			map[string]interface{}{"field": "order"},
+			fmt.Errorf("unknown sort order %q", opts.Order),
+		)
+	}
+
+	return types.WorkspaceListFilter{
+		Runtime:   opts.Runtime,
+		SortBy:    opts.Sort,
+		Ascending: opts.Order == types.SortOrderAsc,
+	}, nil
+}
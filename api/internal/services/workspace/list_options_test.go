@@ -0,0 +1,105 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func TestListWorkspaces_FilterByStatus(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	f.ws.On("List", mock.Anything, metav1.ListOptions{LabelSelector: "user-id=user1"}).Return(&v1.WorkspaceList{Items: []v1.Workspace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ws-3"}, Status: v1.WorkspaceStatus{Phase: v1.WorkspacePhaseActive}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ws-2"}, Status: v1.WorkspaceStatus{Phase: v1.WorkspacePhaseSuspended}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ws-1"}, Status: v1.WorkspaceStatus{Phase: v1.WorkspacePhaseActive}},
+	}}, nil).Once()
+	filter := types.WorkspaceListFilter{IDs: []string{"ws-1", "ws-3"}}
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", filter, 10, 0).Return([]*types.WorkspaceMetadata{
+		{ID: "ws-3", UserID: "user1"}, {ID: "ws-1", UserID: "user1"},
+	}, &types.PaginationMetadata{Total: 2, Limit: 10}, nil)
+
+	result, err := f.svc.ListWorkspaces(ctx, "user1", types.ListOptions{Limit: 10, Phase: "Active"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, result.Items, 2) {
+		assert.Equal(t, "Active", result.Items[0].Phase)
+		assert.Equal(t, "Active", result.Items[1].Phase)
+	}
+	f.ws.AssertNumberOfCalls(t, "List", 1)
+}
+
+func TestListWorkspaces_StatusMatchingNothingPassesEmptyIDs(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	f.ws.On("List", mock.Anything, mock.Anything).Return(&v1.WorkspaceList{}, nil)
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{IDs: []string{}}, 20, 0).
+		Return([]*types.WorkspaceMetadata{}, &types.PaginationMetadata{}, nil)
+
+	result, err := f.svc.ListWorkspaces(ctx, "user1", types.ListOptions{Phase: "Failed"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+}
+
+func TestListWorkspaces_StatusFilterNeedsPhases(t *testing.T) {
+	f := newFixture(t)
+	f.ws.On("List", mock.Anything, mock.Anything).Return((*v1.WorkspaceList)(nil), errors.New("apiserver down"))
+
+	_, err := f.svc.ListWorkspaces(context.Background(), "user1", types.ListOptions{Phase: "Active"})
+
+	assert.ErrorContains(t, err, "workspace_list_failed")
+	f.db.AssertNotCalled(t, "ListWorkspacesFiltered", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListWorkspaces_SortAscendingByRuntime(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	filter := types.WorkspaceListFilter{Runtime: "python:3.11", SortBy: types.WorkspaceSortRuntime, Ascending: true}
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", filter, 10, 0).Return([]*types.WorkspaceMetadata{
+		{ID: "ws-1", Runtime: "python:3.11"},
+	}, &types.PaginationMetadata{Total: 1, Limit: 10}, nil)
+	f.ws.On("List", mock.Anything, mock.Anything).Return(&v1.WorkspaceList{}, nil)
+
+	result, err := f.svc.ListWorkspaces(ctx, "user1", types.ListOptions{
+		Limit: 10, Runtime: "python:3.11", Sort: "runtime", Order: "asc",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Items, 1)
+}
+
+func TestListWorkspaces_RejectsUnknownParameters(t *testing.T) {
+	f := newFixture(t)
+
+	for field, opts := range map[string]types.ListOptions{
+		"status": {Phase: "Running"},
+		"sort":   {Sort: "name; DROP TABLE workspaces"},
+		"order":  {Order: "up"},
+	} {
+		_, err := f.svc.ListWorkspaces(context.Background(), "user1", opts)
+
+		var apiErr *apierrors.APIError
+		if assert.ErrorAs(t, err, &apiErr, field) {
+			assert.Equal(t, apierrors.CodeValidation, apiErr.Code, field)
+			assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode(), field)
+			assert.Equal(t, field, apiErr.Details["field"])
+		}
+	}
+	f.db.AssertNotCalled(t, "ListWorkspacesFiltered", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,24 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"fmt"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// validateLogLevel checks a requested workspace agent log level. Empty is
+// always allowed and keeps the agent default.
+func validateLogLevel(level string) error {
+	if v1.AgentLogLevel(level).IsValid() {
+		return nil
+	}
+	return apierrors.NewValidationError(
+		fmt.Sprintf("invalid logLevel %q: must be one of debug, info, warn, error", level),
+		map[string]interface{}{"field": "logLevel"},
+		fmt.Errorf("unknown log level %q", level),
+	)
+}
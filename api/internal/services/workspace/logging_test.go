@@ -0,0 +1,40 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"testing"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func TestValidateLogLevel(t *testing.T) {
+	for _, l := range []string{"", "debug", "info", "warn", "error"} {
+		if err := validateLogLevel(l); err != nil {
+			t.Errorf("level %q: unexpected error %v", l, err)
+		}
+	}
+	err := validateLogLevel("trace")
+	if err == nil {
+		t.Fatal("unknown log level must be rejected")
+	}
+	if got := priorityErrorType(t, err); got != apierrors.ErrorTypeValidation {
+		t.Errorf("expected validation error, got %s", got)
+	}
+}
+
+func TestBuildWorkspaceCRD_SetsLogging(t *testing.T) {
+	req := types.CreateWorkspaceRequest{Name: "w", Runtime: "base", StorageSize: "1Gi", LogLevel: "debug"}
+	crd := buildWorkspaceCRD("ws-1", "user-1", req, "default")
+	if crd.Spec.Logging == nil || crd.Spec.Logging.Level != v1.AgentLogLevelDebug {
+		t.Errorf("expected spec.logging.level=debug, got %+v", crd.Spec.Logging)
+	}
+
+	req.LogLevel = ""
+	if crd := buildWorkspaceCRD("ws-1", "user-1", req, "default"); crd.Spec.Logging != nil {
+		t.Errorf("empty logLevel must leave spec.logging unset, got %+v", crd.Spec.Logging)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+)
+
+// validateRegion checks a requested placement region against the
+// workspace.allowedRegions instance setting. Empty is always allowed.
+// An empty allow-list (the default) disables placement hints entirely,
+// since an unknown region would leave the pod unschedulable rather than
+// failing fast.
+func (s *Service) validateRegion(ctx context.Context, region string) error {
+	if region == "" {
+		return nil
+	}
+
+	allowed, _ := settings.KeyWorkspaceAllowedRegions.Default().([]string)
+	if s.instanceSettings != nil {
+		if v, err := s.instanceSettings.GetStrings(ctx, settings.KeyWorkspaceAllowedRegions.Name()); err == nil {
+			allowed = v
+		}
+	}
+	for _, r := range allowed {
+		if r == region {
+			return nil
+		}
+	}
+	return apierrors.NewValidationError(
+		fmt.Sprintf("region %q is not available", region),
+		map[string]interface{}{"field": "region", "allowed": allowed},
+		fmt.Errorf("region %q not in allowed set %v", region, allowed),
+	)
+}
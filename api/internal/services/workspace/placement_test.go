@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func regionService(regions ...string) *Service {
+	store := &mockSettingsStore{data: make(map[string]json.RawMessage)}
+	raw, _ := json.Marshal(regions)
+	store.data[settings.KeyWorkspaceAllowedRegions.Name()] = raw
+	return &Service{instanceSettings: settings.NewInstanceService(store, nil)}
+}
+
+func TestValidateRegion_AllowedRegionAccepted(t *testing.T) {
+	svc := regionService("us-east-1", "eu-west-1")
+	for _, r := range []string{"", "eu-west-1"} {
+		if err := svc.validateRegion(context.Background(), r); err != nil {
+			t.Errorf("region %q: unexpected error %v", r, err)
+		}
+	}
+}
+
+func TestValidateRegion_UnknownRegionRejected(t *testing.T) {
+	svc := regionService("us-east-1")
+	err := svc.validateRegion(context.Background(), "ap-south-1")
+	if err == nil {
+		t.Fatal("region outside the allowed set must be rejected")
+	}
+	if got := priorityErrorType(t, err); got != apierrors.ErrorTypeValidation {
+		t.Errorf("expected validation error, got %s", got)
+	}
+}
+
+func TestValidateRegion_DisabledByDefault(t *testing.T) {
+	svc := &Service{}
+	if err := svc.validateRegion(context.Background(), "us-east-1"); err == nil {
+		t.Error("placement hints must be rejected when no regions are configured")
+	}
+}
+
+func TestBuildWorkspaceCRD_SetsRegion(t *testing.T) {
+	req := types.CreateWorkspaceRequest{Name: "w", Runtime: "base", StorageSize: "1Gi", Region: "eu-west-1"}
+	crd := buildWorkspaceCRD("ws-1", "user-1", req, "default")
+	if crd.Spec.Region != "eu-west-1" {
+		t.Errorf("expected spec.region=eu-west-1, got %q", crd.Spec.Region)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+)
+
+type userRoleCtxKey struct{}
+
+// ContextWithUserRole adds the caller's role (users.role) to context so
+// role-gated request fields such as priority can be checked in the service.
+func ContextWithUserRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, userRoleCtxKey{}, role)
+}
+
+// isAdminContext matches middleware.AdminGuard exactly, so the two admin
+// checks cannot disagree.
+func isAdminContext(ctx context.Context) bool {
+	role, _ := ctx.Value(userRoleCtxKey{}).(string)
+	return role == "admin"
+}
+
+// validatePriority checks a requested workspace priority. Empty is always
+// allowed. Admins may request any defined priority; everyone else is
+// limited to the workspace.allowedPriorities instance setting, which
+// falls back to its registry default when settings are unavailable.
+func (s *Service) validatePriority(ctx context.Context, priority string) error {
+	if priority == "" {
+		return nil
+	}
+	if !v1.WorkspacePriority(priority).IsValid() {
+		return apierrors.NewValidationError(
+			fmt.Sprintf("invalid priority %q: must be one of low, normal, high", priority),
+			map[string]interface{}{"field": "priority"},
+			fmt.Errorf("unknown priority %q", priority),
+		)
+	}
+	if isAdminContext(ctx) {
+		return nil
+	}
+
+	allowed, _ := settings.KeyWorkspaceAllowedPriorities.Default().([]string)
+	if s.instanceSettings != nil {
+		if v, err := s.instanceSettings.GetStrings(ctx, settings.KeyWorkspaceAllowedPriorities.Name()); err == nil {
+			allowed = v
+		}
+	}
+	for _, p := range allowed {
+		if p == priority {
+			return nil
+		}
+	}
+	return apierrors.NewForbiddenError(
+		fmt.Sprintf("priority %q is not allowed for your account", priority),
+		fmt.Errorf("priority %q not in allowed set %v", priority, allowed),
+	)
+}
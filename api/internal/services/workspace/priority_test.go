@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func priorityErrorType(t *testing.T, err error) apierrors.ErrorType {
+	t.Helper()
+	var apiErr *apierrors.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T (%v)", err, err)
+	}
+	return apiErr.Type
+}
+
+func TestValidatePriority_DefaultAllowedSet(t *testing.T) {
+	svc := &Service{}
+	ctx := context.Background()
+
+	for _, p := range []string{"", "low", "normal"} {
+		if err := svc.validatePriority(ctx, p); err != nil {
+			t.Errorf("priority %q: unexpected error %v", p, err)
+		}
+	}
+	err := svc.validatePriority(ctx, "high")
+	if err == nil {
+		t.Fatal("non-admin must not get high priority under the default allowed set")
+	}
+	if got := priorityErrorType(t, err); got != apierrors.ErrorTypeForbidden {
+		t.Errorf("expected forbidden, got %s", got)
+	}
+}
+
+func TestValidatePriority_AdminMayRequestAny(t *testing.T) {
+	svc := &Service{}
+	ctx := ContextWithUserRole(context.Background(), "admin")
+	if err := svc.validatePriority(ctx, "high"); err != nil {
+		t.Errorf("admin high priority: unexpected error %v", err)
+	}
+
+	// AdminGuard compares the role exactly; so must the priority check.
+	ctx = ContextWithUserRole(context.Background(), "Admin")
+	if err := svc.validatePriority(ctx, "high"); err == nil {
+		t.Error(`role "Admin" must not be treated as admin`)
+	}
+}
+
+func TestValidatePriority_UnknownRejected(t *testing.T) {
+	svc := &Service{}
+	ctx := ContextWithUserRole(context.Background(), "admin")
+	err := svc.validatePriority(ctx, "urgent")
+	if err == nil {
+		t.Fatal("unknown priority must be rejected even for admins")
+	}
+	if got := priorityErrorType(t, err); got != apierrors.ErrorTypeValidation {
+		t.Errorf("expected validation error, got %s", got)
+	}
+}
+
+func TestValidatePriority_InstanceSettingOverridesDefault(t *testing.T) {
+	store := &mockSettingsStore{data: make(map[string]json.RawMessage)}
+	raw, _ := json.Marshal([]string{"high"})
+	store.data[settings.KeyWorkspaceAllowedPriorities.Name()] = raw
+	svc := &Service{instanceSettings: settings.NewInstanceService(store, nil)}
+	ctx := ContextWithUserRole(context.Background(), "user")
+
+	if err := svc.validatePriority(ctx, "high"); err != nil {
+		t.Errorf("high is in the configured allowed set: %v", err)
+	}
+	if err := svc.validatePriority(ctx, "low"); err == nil {
+		t.Error("low is not in the configured allowed set and must be rejected")
+	}
+}
+
+func TestBuildWorkspaceCRD_SetsPriority(t *testing.T) {
+	req := types.CreateWorkspaceRequest{Name: "w", Runtime: "base", StorageSize: "1Gi", Priority: "high"}
+	crd := buildWorkspaceCRD("ws-1", "user-1", req, "default")
+	if crd.Spec.Priority != v1.WorkspacePriorityHigh {
+		t.Errorf("expected spec.priority=high, got %q", crd.Spec.Priority)
+	}
+}
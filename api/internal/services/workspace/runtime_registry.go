@@ -0,0 +1,136 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	pkginterfaces "github.com/lenaxia/llmsafespaces/pkg/interfaces"
+	"github.com/lenaxia/llmsafespaces/pkg/kubernetes"
+)
+
+// defaultRuntimeCacheResync is the RuntimeEnvironment informer's resync
+// period when Config.RuntimeCacheResync is zero. Watch events keep the
+// cache current; the resync only bounds drift after a missed event.
+const defaultRuntimeCacheResync = 10 * time.Minute
+
+// runtimeSource is where RuntimeEnvironment lookups read from: the
+// informer-backed registry once it has synced, the API server otherwise.
+type runtimeSource interface {
+	// getRuntime returns a NotFound error when no environment has name.
+	getRuntime(ctx context.Context, name string) (*v1.RuntimeEnvironment, error)
+	listRuntimes(ctx context.Context) ([]v1.RuntimeEnvironment, error)
+}
+
+// runtimeRegistry is the shared, in-memory view of RuntimeEnvironments.
+// An informer lists them once and then follows the watch, so an operator
+// repointing a runtime's image or resources is seen by the next lookup
+// without a restart and without a round trip per workspace create.
+type runtimeRegistry struct {
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newRuntimeRegistry(client pkginterfaces.LLMSafespacesV1Interface, resync time.Duration) *runtimeRegistry {
+	if resync == 0 {
+		resync = defaultRuntimeCacheResync
+	}
+	return &runtimeRegistry{
+		informer: kubernetes.NewInformerFactory(client, resync, "").RuntimeEnvironmentInformer(),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (r *runtimeRegistry) start() {
+	go r.informer.Run(r.stopCh)
+}
+
+func (r *runtimeRegistry) stop() {
+	r.stopOnce.Do(func() { close(r.stopCh) })
+}
+
+// synced reports whether the initial list has landed. Until then lookups
+// go to the API server so creates right after startup are not rejected
+// against an empty cache.
+func (r *runtimeRegistry) synced() bool {
+	return r.informer.HasSynced()
+}
+
+func (r *runtimeRegistry) getRuntime(_ context.Context, name string) (*v1.RuntimeEnvironment, error) {
+	obj, exists, err := r.informer.GetStore().GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	env, ok := obj.(*v1.RuntimeEnvironment)
+	if !exists || !ok {
+		return nil, k8serrors.NewNotFound(v1.Resource("runtimeenvironments"), name)
+	}
+	return env.DeepCopy(), nil
+}
+
+func (r *runtimeRegistry) listRuntimes(context.Context) ([]v1.RuntimeEnvironment, error) {
+	objs := r.informer.GetStore().List()
+	envs := make([]v1.RuntimeEnvironment, 0, len(objs))
+	for _, obj := range objs {
+		if env, ok := obj.(*v1.RuntimeEnvironment); ok {
+			envs = append(envs, *env.DeepCopy())
+		}
+	}
+	return envs, nil
+}
+
+// liveRuntimes reads RuntimeEnvironments straight from the API server.
+type liveRuntimes struct {
+	envs pkginterfaces.RuntimeEnvironmentInterface
+}
+
+func (l liveRuntimes) getRuntime(ctx context.Context, name string) (*v1.RuntimeEnvironment, error) {
+	return l.envs.Get(ctx, name, metav1.GetOptions{})
+}
+
+func (l liveRuntimes) listRuntimes(ctx context.Context) ([]v1.RuntimeEnvironment, error) {
+	list, err := l.envs.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// startRuntimeRegistry starts the RuntimeEnvironment informer unless the
+// cache is disabled (negative Config.RuntimeCacheResync). Without it every
+// lookup reads the API server.
+func (s *Service) startRuntimeRegistry() {
+	if s.config.RuntimeCacheResync < 0 {
+		s.logger.Info("RuntimeEnvironment cache disabled; runtime lookups read the API server")
+		return
+	}
+	v1Client, err := s.k8sClient.LlmsafespacesV1()
+	if err != nil {
+		s.logger.Warn("RuntimeEnvironment cache unavailable; runtime lookups read the API server", "error", err.Error())
+		return
+	}
+	s.runtimes = newRuntimeRegistry(v1Client, s.config.RuntimeCacheResync)
+	s.runtimes.start()
+}
+
+// runtimeSource returns the registry once it has synced, else the API
+// server.
+func (s *Service) runtimeSource() (runtimeSource, error) {
+	if s.runtimes != nil && s.runtimes.synced() {
+		return s.runtimes, nil
+	}
+	v1Client, err := s.k8sClient.LlmsafespacesV1()
+	if err != nil {
+		return nil, err
+	}
+	return liveRuntimes{envs: v1Client.RuntimeEnvironments()}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// startRegistry serves envs from an informer on f.rte and waits for its
+// initial list. The returned watcher pushes later changes.
+func startRegistry(t *testing.T, f *fixture, envs ...*v1.RuntimeEnvironment) *watch.FakeWatcher {
+	t.Helper()
+	list := &v1.RuntimeEnvironmentList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+	for _, env := range envs {
+		list.Items = append(list.Items, *env)
+	}
+	fw := watch.NewFake()
+	f.rte.ExpectedCalls = nil
+	f.rte.On("List", mock.Anything, mock.Anything).Return(list, nil)
+	f.rte.On("Watch", mock.Anything, mock.Anything).Return(fw, nil)
+
+	require.NoError(t, f.svc.Start())
+	t.Cleanup(func() { _ = f.svc.Stop() })
+	require.Eventually(t, f.svc.runtimes.synced, 5*time.Second, 10*time.Millisecond)
+	return fw
+}
+
+func TestRuntimeRegistry_CacheHitsAvoidAPICalls(t *testing.T) {
+	f := newFixture(t)
+	node := &v1.RuntimeEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodejs-lts"},
+		Spec:       v1.RuntimeEnvironmentSpec{Image: "ghcr.io/example/node:20", Language: "nodejs", Version: "20"},
+	}
+	startRegistry(t, f, pythonEnv("1", "2Gi"), node)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		for runtime, want := range map[string]string{"python:3.11": "python-3.11", "nodejs:20": "nodejs-lts"} {
+			env, err := f.svc.lookupRuntimeEnvironment(ctx, runtime)
+			require.NoError(t, err, runtime)
+			require.NotNil(t, env, runtime)
+			assert.Equal(t, want, env.Name, runtime)
+		}
+	}
+	_, err := f.svc.lookupRuntimeEnvironment(ctx, "ruby:3")
+	assert.Error(t, err, "an unknown runtime is still unsupported when served from the cache")
+
+	f.rte.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	f.rte.AssertNumberOfCalls(t, "List", 1)
+}
+
+func TestRuntimeRegistry_UpdateRefreshesCache(t *testing.T) {
+	f := newFixture(t)
+	env := pythonEnv("1", "2Gi")
+	fw := startRegistry(t, f, env)
+	ctx := context.Background()
+
+	updated := env.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Spec.ResourceRequirements.RecommendedMemory = "4Gi"
+	fw.Modify(updated)
+
+	assert.Eventually(t, func() bool {
+		got, err := f.svc.lookupRuntimeEnvironment(ctx, "python:3.11")
+		return err == nil && got != nil && got.Spec.ResourceRequirements.RecommendedMemory == "4Gi"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	fw.Delete(updated)
+	assert.Eventually(t, func() bool {
+		_, err := f.svc.lookupRuntimeEnvironment(ctx, "python:3.11")
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond, "a deleted RuntimeEnvironment must stop resolving")
+	f.rte.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRuntimeRegistry_DisabledReadsAPIServer(t *testing.T) {
+	f := newFixture(t)
+	f.svc.config.RuntimeCacheResync = -1
+	f.withRuntimeEnvironments(pythonEnv("", ""))
+
+	require.NoError(t, f.svc.Start())
+	assert.Nil(t, f.svc.runtimes)
+
+	env, err := f.svc.lookupRuntimeEnvironment(context.Background(), "python-3.11")
+	require.NoError(t, err)
+	require.NotNil(t, env)
+	f.rte.AssertCalled(t, "Get", mock.Anything, "python-3.11", mock.Anything)
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// lookupRuntimeEnvironment resolves a workspace runtime to the
+// RuntimeEnvironment that serves it, matching like the controller's
+// resolver: exact name, then "lang:ver" as "lang-ver", then the
+// lexically first environment whose language and version match. This lets
+// operators repoint or patch the image behind a runtime name without
+// clients changing their requests. Reads go through the RuntimeEnvironment
+// registry, so a create costs no API-server round trip once it has synced.
+//
+// It returns nil without error for an empty runtime or an explicit image
+// reference (contains "/"), which the controller uses as-is, and an
+// unsupported_runtime error when nothing matches — otherwise the
+// workspace would be created only for the controller to fail it.
+// Lookup failures are logged and ignored: the controller re-resolves the
+// runtime and stays authoritative, so an API-server hiccup must not block
+// creation.
+func (s *Service) lookupRuntimeEnvironment(ctx context.Context, runtime string) (*v1.RuntimeEnvironment, error) {
+	if runtime == "" || strings.Contains(runtime, "/") {
+		return nil, nil
+	}
+	envs, err := s.runtimeSource()
+	if err != nil {
+		s.logger.Warn("runtime lookup unavailable", "runtime", runtime, "error", err.Error())
+		return nil, nil
+	}
+
+	names := []string{runtime}
+	if strings.Contains(runtime, ":") {
+		names = append(names, strings.ReplaceAll(runtime, ":", "-"))
+	}
+	for _, name := range names {
+		env, err := envs.getRuntime(ctx, name)
+		if err == nil {
+			return env, nil
+		}
+		if !k8serrors.IsNotFound(err) {
+			s.logger.Warn("failed to look up RuntimeEnvironment", "runtime", name, "error", err.Error())
+			return nil, nil
+		}
+	}
+
+	if idx := strings.Index(runtime, ":"); idx > 0 {
+		lang, ver := runtime[:idx], runtime[idx+1:]
+		items, err := envs.listRuntimes(ctx)
+		if err != nil {
+			s.logger.Warn("failed to list RuntimeEnvironments", "runtime", runtime, "error", err.Error())
+			return nil, nil
+		}
+		var best *v1.RuntimeEnvironment
+		for i := range items {
+			e := &items[i]
+			if e.Spec.Language == lang && e.Spec.Version == ver && (best == nil || e.Name < best.Name) {
+				best = e
+			}
+		}
+		if best != nil {
+			return best, nil
+		}
+	}
+
+	return nil, &apierrors.APIError{
+		Type:    apierrors.ErrorTypeBadRequest,
+		Code:    apierrors.CodeUnsupportedRuntime,
+		Message: fmt.Sprintf("runtime %q is not supported: no RuntimeEnvironment matches it", runtime),
+		Details: map[string]interface{}{"runtime": runtime},
+	}
+}
+
+// runtimeDefaultResources returns the recommended CPU/memory of a
+// workspace's RuntimeEnvironment, or nil when there is none (env is nil
+// for explicit image references) or it makes no usable recommendation.
+// Precedence at create time is: requested size, then this, then the
+// workspace.defaultResources settings, then the controller's built-in
+// default.
+//
+// The RuntimeEnvironment schema accepts any quantity ("1", "1G") but the
+// workspace webhook only millicores and Ki/Mi/Gi, so recommendations are
+// converted to those forms; one that cannot be is logged and left for the
+// defaults to fill rather than failing every create of that runtime.
+func (s *Service) runtimeDefaultResources(env *v1.RuntimeEnvironment) *v1.ResourceRequirements {
+	if env == nil || env.Spec.ResourceRequirements == nil {
+		return nil
+	}
+	rr := env.Spec.ResourceRequirements
+	res := &v1.ResourceRequirements{}
+	if rr.RecommendedCPU != "" {
+		if cpu, ok := canonicalCPU(rr.RecommendedCPU); ok {
+			res.CPU = cpu
+		} else {
+			s.logger.Warn("ignoring RuntimeEnvironment recommendedCpu the workspace webhook would reject",
+				"runtimeEnvironment", env.Name, "recommendedCpu", rr.RecommendedCPU)
+		}
+	}
+	if rr.RecommendedMemory != "" {
+		if mem, ok := canonicalMemory(rr.RecommendedMemory); ok {
+			res.Memory = mem
+		} else {
+			s.logger.Warn("ignoring RuntimeEnvironment recommendedMemory the workspace webhook would reject",
+				"runtimeEnvironment", env.Name, "recommendedMemory", rr.RecommendedMemory)
+		}
+	}
+	if res.CPU == "" && res.Memory == "" {
+		return nil
+	}
+	return res
+}
+
+// canonicalCPU converts a CPU quantity to millicores ("1" -> "1000m").
+func canonicalCPU(v string) (string, bool) {
+	q, err := resource.ParseQuantity(v)
+	if err != nil || q.MilliValue() <= 0 {
+		return "", false
+	}
+	cpu := fmt.Sprintf("%dm", q.MilliValue())
+	return cpu, sizeCPUPattern.MatchString(cpu)
+}
+
+// canonicalMemory converts a memory quantity to the largest binary unit
+// that represents it exactly ("1G" has none and is rejected).
+func canonicalMemory(v string) (string, bool) {
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return "", false
+	}
+	bytes := q.Value()
+	for _, u := range []struct {
+		suffix string
+		size   int64
+	}{{"Gi", 1 << 30}, {"Mi", 1 << 20}, {"Ki", 1 << 10}} {
+		if bytes > 0 && bytes%u.size == 0 {
+			mem := fmt.Sprintf("%d%s", bytes/u.size, u.suffix)
+			return mem, sizeMemoryPattern.MatchString(mem)
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// withRuntimeEnvironments replaces the fixture's "every runtime is
+// installed" default with exactly envs.
+func (f *fixture) withRuntimeEnvironments(envs ...*v1.RuntimeEnvironment) {
+	f.rte.ExpectedCalls = nil
+	list := &v1.RuntimeEnvironmentList{}
+	for _, env := range envs {
+		f.rte.On("Get", mock.Anything, env.Name, mock.Anything).Return(env, nil).Maybe()
+		list.Items = append(list.Items, *env)
+	}
+	f.rte.On("Get", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, k8serrors.NewNotFound(v1.Resource("runtimeenvironments"), "")).Maybe()
+	f.rte.On("List", mock.Anything, mock.Anything).Return(list, nil).Maybe()
+}
+
+func pythonEnv(cpu, memory string) *v1.RuntimeEnvironment {
+	return &v1.RuntimeEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Name: "python-3.11"},
+		Spec: v1.RuntimeEnvironmentSpec{
+			Image: "ghcr.io/example/python:3.11", Language: "python", Version: "3.11",
+			ResourceRequirements: &v1.RuntimeResourceRequirements{RecommendedCPU: cpu, RecommendedMemory: memory},
+		},
+	}
+}
+
+// createdResources creates a workspace through CreateWorkspace and returns
+// the resources on the Workspace CR it submitted.
+func createdResources(t *testing.T, f *fixture, req types.CreateWorkspaceRequest) *v1.ResourceRequirements {
+	t.Helper()
+	f.svc.SetInstanceSettings(settings.NewInstanceService(&mockSettingsStore{data: map[string]json.RawMessage{}}, nil))
+
+	var submitted *v1.Workspace
+	f.ws.ExpectedCalls = nil
+	f.ws.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { submitted = args.Get(1).(*v1.Workspace) }).
+		Return(crdWorkspace("ws-1", "default", "user-1", "1Gi"), nil)
+	f.db.ExpectedCalls = nil
+	f.db.On("CreateWorkspace", mock.Anything, mock.Anything).Return(nil)
+
+	req.Name, req.StorageSize = "ws", "1Gi"
+	_, err := f.svc.CreateWorkspace(context.Background(), "user-1", req)
+	require.NoError(t, err)
+	require.NotNil(t, submitted)
+	return submitted.Spec.Resources
+}
+
+func TestCreateResources_RequestedSizeWins(t *testing.T) {
+	f := newFixture(t)
+	f.withRuntimeEnvironments(pythonEnv("1", "2Gi"))
+
+	res := createdResources(t, f, types.CreateWorkspaceRequest{Runtime: "python:3.11", Size: "large"})
+	assert.Equal(t, &v1.ResourceRequirements{CPU: "2000m", Memory: "4Gi"}, res)
+}
+
+func TestCreateResources_RuntimeRecommendation(t *testing.T) {
+	f := newFixture(t)
+	f.withRuntimeEnvironments(pythonEnv("1", "2Gi"))
+
+	res := createdResources(t, f, types.CreateWorkspaceRequest{Runtime: "python:3.11"})
+	assert.Equal(t, &v1.ResourceRequirements{CPU: "1000m", Memory: "2Gi"}, res)
+}
+
+func TestCreateResources_PartialRuntimeRecommendationFilledFromDefaults(t *testing.T) {
+	f := newFixture(t)
+	f.withRuntimeEnvironments(pythonEnv("", "3Gi"))
+
+	res := createdResources(t, f, types.CreateWorkspaceRequest{Runtime: "python-3.11"})
+	assert.Equal(t, &v1.ResourceRequirements{CPU: "500m", Memory: "3Gi"}, res)
+}
+
+func TestCreateResources_GlobalDefaultWithoutRecommendation(t *testing.T) {
+	f := newFixture(t)
+
+	for _, runtime := range []string{"ruby:3", "ghcr.io/example/custom:latest"} {
+		res := createdResources(t, f, types.CreateWorkspaceRequest{Runtime: runtime})
+		assert.Equal(t, &v1.ResourceRequirements{CPU: "500m", Memory: "1Gi"}, res, runtime)
+	}
+	f.rte.AssertNotCalled(t, "Get", mock.Anything, "ghcr.io/example/custom:latest", mock.Anything)
+}
+
+func TestLookupRuntimeEnvironment_Resolution(t *testing.T) {
+	f := newFixture(t)
+	py := pythonEnv("", "")
+	node := &v1.RuntimeEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodejs-lts"},
+		Spec:       v1.RuntimeEnvironmentSpec{Image: "ghcr.io/example/node:20", Language: "nodejs", Version: "20"},
+	}
+	f.withRuntimeEnvironments(py, node)
+	ctx := context.Background()
+
+	for runtime, want := range map[string]string{
+		"python-3.11": "python-3.11", // exact name
+		"python:3.11": "python-3.11", // ':' as '-'
+		"nodejs:20":   "nodejs-lts",  // language:version
+	} {
+		env, err := f.svc.lookupRuntimeEnvironment(ctx, runtime)
+		if assert.NoError(t, err, runtime) && assert.NotNil(t, env, runtime) {
+			assert.Equal(t, want, env.Name, runtime)
+		}
+	}
+
+	for _, runtime := range []string{"", "ghcr.io/example/custom:latest"} {
+		env, err := f.svc.lookupRuntimeEnvironment(ctx, runtime)
+		assert.NoError(t, err, runtime)
+		assert.Nil(t, env, runtime)
+	}
+}
+
+func TestCreateWorkspace_UnsupportedRuntime(t *testing.T) {
+	f := newFixture(t)
+	f.withRuntimeEnvironments(pythonEnv("", ""))
+	f.svc.SetOrgStore(newStubOrgChecker())
+
+	for _, runtime := range []string{"ruby:3", "python", "python:3.12"} {
+		_, err := f.svc.CreateWorkspace(context.Background(), "user-1",
+			types.CreateWorkspaceRequest{Name: "ws", Runtime: runtime, StorageSize: "1Gi"})
+
+		var apiErr *apierrors.APIError
+		if assert.ErrorAs(t, err, &apiErr, runtime) {
+			assert.Equal(t, apierrors.CodeUnsupportedRuntime, apiErr.Code, runtime)
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode(), runtime)
+		}
+	}
+	f.ws.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	f.db.AssertNotCalled(t, "CreateWorkspace", mock.Anything, mock.Anything)
+}
+
+func TestLookupRuntimeEnvironment_LookupFailureFailsOpen(t *testing.T) {
+	f := newFixture(t)
+	f.rte.ExpectedCalls = nil
+	f.rte.On("Get", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, k8serrors.NewServiceUnavailable("apiserver down"))
+
+	env, err := f.svc.lookupRuntimeEnvironment(context.Background(), "python:3.11")
+	assert.NoError(t, err, "the controller re-resolves the runtime; a lookup hiccup must not block creation")
+	assert.Nil(t, env)
+}
+
+func TestRuntimeDefaultResources_CanonicalizesRecommendations(t *testing.T) {
+	f := newFixture(t)
+	cases := []struct {
+		cpu, memory string
+		want        *v1.ResourceRequirements
+	}{
+		{"1", "1Gi", &v1.ResourceRequirements{CPU: "1000m", Memory: "1Gi"}},
+		{"0.5", "1024Mi", &v1.ResourceRequirements{CPU: "500m", Memory: "1Gi"}},
+		{"250m", "1536Mi", &v1.ResourceRequirements{CPU: "250m", Memory: "1536Mi"}},
+		{"1.5", "1G", &v1.ResourceRequirements{CPU: "1500m"}},
+		{"lots", "", nil},
+	}
+	for _, tc := range cases {
+		got := f.svc.runtimeDefaultResources(pythonEnv(tc.cpu, tc.memory))
+		assert.Equal(t, tc.want, got, "%s/%s", tc.cpu, tc.memory)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+)
+
+// validateTerminationGrace checks a requested pod shutdown grace period
+// against the workspace.maxTerminationGracePeriodSeconds instance setting.
+// Nil is always allowed and leaves the controller default in place.
+func (s *Service) validateTerminationGrace(ctx context.Context, grace *int64) error {
+	if grace == nil {
+		return nil
+	}
+
+	ceiling, _ := settings.KeyWorkspaceMaxTerminationGrace.Default().(int)
+	if s.instanceSettings != nil {
+		if v, err := s.instanceSettings.GetInt(ctx, settings.KeyWorkspaceMaxTerminationGrace.Name()); err == nil {
+			ceiling = v
+		}
+	}
+	if *grace < 0 || *grace > int64(ceiling) {
+		return apierrors.NewValidationError(
+			fmt.Sprintf("terminationGracePeriodSeconds must be between 0 and %d", ceiling),
+			map[string]interface{}{"field": "terminationGracePeriodSeconds", "max": ceiling},
+			fmt.Errorf("termination grace %d outside [0, %d]", *grace, ceiling),
+		)
+	}
+	return nil
+}
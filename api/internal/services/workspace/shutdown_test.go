@@ -0,0 +1,63 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func graceService(ceiling int) *Service {
+	store := &mockSettingsStore{data: make(map[string]json.RawMessage)}
+	raw, _ := json.Marshal(ceiling)
+	store.data[settings.KeyWorkspaceMaxTerminationGrace.Name()] = raw
+	return &Service{instanceSettings: settings.NewInstanceService(store, nil)}
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestValidateTerminationGrace_WithinCeilingAccepted(t *testing.T) {
+	svc := graceService(120)
+	for _, g := range []*int64{nil, int64Ptr(0), int64Ptr(120)} {
+		if err := svc.validateTerminationGrace(context.Background(), g); err != nil {
+			t.Errorf("grace %v: unexpected error %v", g, err)
+		}
+	}
+}
+
+func TestValidateTerminationGrace_OverCeilingRejected(t *testing.T) {
+	svc := graceService(120)
+	for _, g := range []int64{121, -1} {
+		err := svc.validateTerminationGrace(context.Background(), int64Ptr(g))
+		if err == nil {
+			t.Fatalf("grace %d must be rejected", g)
+		}
+		if got := priorityErrorType(t, err); got != apierrors.ErrorTypeValidation {
+			t.Errorf("expected validation error, got %s", got)
+		}
+	}
+}
+
+func TestValidateTerminationGrace_DefaultCeilingWithoutSettings(t *testing.T) {
+	svc := &Service{}
+	if err := svc.validateTerminationGrace(context.Background(), int64Ptr(300)); err != nil {
+		t.Errorf("registry default ceiling should allow 300s: %v", err)
+	}
+	if err := svc.validateTerminationGrace(context.Background(), int64Ptr(301)); err == nil {
+		t.Error("grace above the registry default ceiling must be rejected")
+	}
+}
+
+func TestBuildWorkspaceCRD_SetsTerminationGrace(t *testing.T) {
+	req := types.CreateWorkspaceRequest{Name: "w", Runtime: "base", StorageSize: "1Gi", TerminationGracePeriodSeconds: int64Ptr(60)}
+	crd := buildWorkspaceCRD("ws-1", "user-1", req, "default")
+	if crd.Spec.TerminationGracePeriodSeconds == nil || *crd.Spec.TerminationGracePeriodSeconds != 60 {
+		t.Errorf("expected spec.terminationGracePeriodSeconds=60, got %v", crd.Spec.TerminationGracePeriodSeconds)
+	}
+}
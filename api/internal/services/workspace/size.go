@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+)
+
+// resolveSize maps a named size to the CPU/memory of the matching
+// workspace.sizeProfiles entry. Empty returns nil so the runtime
+// recommendation or the workspace.defaultResources settings apply.
+func (s *Service) resolveSize(ctx context.Context, size string) (*v1.ResourceRequirements, error) {
+	if size == "" {
+		return nil, nil
+	}
+
+	entries, _ := settings.KeyWorkspaceSizeProfiles.Default().([]string)
+	if s.instanceSettings != nil {
+		if v, err := s.instanceSettings.GetStrings(ctx, settings.KeyWorkspaceSizeProfiles.Name()); err == nil {
+			entries = v
+		}
+	}
+	profiles := s.parseSizeProfiles(entries)
+	if res, ok := profiles[size]; ok {
+		return &res, nil
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return nil, apierrors.NewValidationError(
+		fmt.Sprintf("size %q is not available", size),
+		map[string]interface{}{"field": "size", "allowed": names},
+		fmt.Errorf("size %q not in profiles %v", size, names),
+	)
+}
+
+// Size profile quantities must pass the same patterns as the workspace
+// webhook; resource.ParseQuantity would also accept forms (e.g. a bare
+// "2") that the webhook then rejects on every sized create.
+var (
+	sizeCPUPattern    = regexp.MustCompile(settings.CPUQuantityPattern)
+	sizeMemoryPattern = regexp.MustCompile(settings.MemoryQuantityPattern)
+)
+
+// parseSizeProfiles parses "name=cpu/memory" entries. The settings schema
+// cannot validate list items, so malformed entries are logged and skipped
+// rather than failing every sized create.
+func (s *Service) parseSizeProfiles(entries []string) map[string]v1.ResourceRequirements {
+	profiles := make(map[string]v1.ResourceRequirements, len(entries))
+	for _, entry := range entries {
+		name, res, err := parseSizeProfile(entry)
+		if err != nil {
+			s.logger.Warn("ignoring malformed workspace.sizeProfiles entry",
+				"entry", entry, "error", err.Error())
+			continue
+		}
+		profiles[name] = res
+	}
+	return profiles
+}
+
+func parseSizeProfile(entry string) (string, v1.ResourceRequirements, error) {
+	name, spec, ok := strings.Cut(strings.TrimSpace(entry), "=")
+	if !ok || name == "" {
+		return "", v1.ResourceRequirements{}, fmt.Errorf("expected name=cpu/memory")
+	}
+	cpu, mem, ok := strings.Cut(spec, "/")
+	if !ok {
+		return "", v1.ResourceRequirements{}, fmt.Errorf("expected name=cpu/memory")
+	}
+	if !sizeCPUPattern.MatchString(cpu) {
+		return "", v1.ResourceRequirements{}, fmt.Errorf("invalid cpu %q: use millicores (e.g. 2000m) or decimal cores (e.g. 2.0)", cpu)
+	}
+	if !sizeMemoryPattern.MatchString(mem) {
+		return "", v1.ResourceRequirements{}, fmt.Errorf("invalid memory %q: use a positive Ki, Mi or Gi quantity", mem)
+	}
+	return name, v1.ResourceRequirements{CPU: cpu, Memory: mem}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/settings"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+func sizeService(t *testing.T, profiles ...string) *Service {
+	t.Helper()
+	f := newFixture(t)
+	store := &mockSettingsStore{data: make(map[string]json.RawMessage)}
+	if profiles != nil {
+		raw, _ := json.Marshal(profiles)
+		store.data[settings.KeyWorkspaceSizeProfiles.Name()] = raw
+	}
+	f.svc.SetInstanceSettings(settings.NewInstanceService(store, nil))
+	return f.svc
+}
+
+func TestResolveSize_DefaultProfiles(t *testing.T) {
+	svc := sizeService(t)
+	res, err := svc.resolveSize(context.Background(), "large")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res == nil || res.CPU != "2000m" || res.Memory != "4Gi" {
+		t.Errorf("expected large=2000m/4Gi, got %+v", res)
+	}
+}
+
+func TestParseSizeProfile_DefaultsPassWebhookPatterns(t *testing.T) {
+	for _, entry := range settings.KeyWorkspaceSizeProfiles.Default().([]string) {
+		if _, _, err := parseSizeProfile(entry); err != nil {
+			t.Errorf("default profile %q rejected: %v", entry, err)
+		}
+	}
+}
+
+func TestResolveSize_EmptyKeepsPlatformDefaults(t *testing.T) {
+	res, err := sizeService(t).resolveSize(context.Background(), "")
+	if err != nil || res != nil {
+		t.Errorf("empty size must resolve to nil, got %+v, %v", res, err)
+	}
+}
+
+func TestResolveSize_ConfiguredProfilesSkipMalformed(t *testing.T) {
+	svc := sizeService(t, "xl=4000m/16Gi", "broken", "zero=0m/1Gi", "bad=abc/1Gi", "bare=4/16Gi", "lower=1.5/8gi")
+	res, err := svc.resolveSize(context.Background(), "xl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.CPU != "4000m" || res.Memory != "16Gi" {
+		t.Errorf("expected xl=4000m/16Gi, got %+v", res)
+	}
+	for _, name := range []string{"broken", "zero", "bad", "bare", "lower", "small"} {
+		if _, err := svc.resolveSize(context.Background(), name); err == nil {
+			t.Errorf("size %q must not resolve", name)
+		}
+	}
+}
+
+func TestResolveSize_UnknownSizeRejected(t *testing.T) {
+	_, err := sizeService(t).resolveSize(context.Background(), "huge")
+	if err == nil {
+		t.Fatal("unknown size must be rejected")
+	}
+	if got := priorityErrorType(t, err); got != apierrors.ErrorTypeValidation {
+		t.Errorf("expected validation error, got %s", got)
+	}
+}
+
+func TestResolveSize_TakesPrecedenceOverDefaultResources(t *testing.T) {
+	svc := sizeService(t)
+	ctx := context.Background()
+
+	res, err := svc.resolveSize(ctx, "small")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	crd := buildWorkspaceCRD("ws-1", "user-1", types.CreateWorkspaceRequest{Name: "w", Runtime: "base", StorageSize: "1Gi", Size: "small"}, "default")
+	crd.Spec.Resources = res
+	svc.applyWorkspaceDefaults(ctx, crd)
+
+	if crd.Spec.Resources.CPU != "250m" || crd.Spec.Resources.Memory != "512Mi" {
+		t.Errorf("size profile must win over workspace.defaultResources, got %+v", crd.Spec.Resources)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// Long-poll bounds for GET /workspaces/:id/status?wait=true. The maximum
+// stays well under typical ingress idle timeouts (60s) so a held request
+// is never cut by a proxy before it returns.
+const (
+	DefaultStatusWaitTimeout = 30 * time.Second
+	MaxStatusWaitTimeout     = 55 * time.Second
+)
+
+// WaitWorkspaceStatus long-polls the workspace status. It returns as soon
+// as the status changes relative to the CRD read at call time, or with the
+// unchanged status once timeout elapses. A single-object watch from the
+// initial resourceVersion wakes the waiter, so no change between the read
+// and the watch start can be missed. timeout is clamped to
+// (0, MaxStatusWaitTimeout]; zero selects DefaultStatusWaitTimeout.
+func (s *Service) WaitWorkspaceStatus(ctx context.Context, userID, workspaceID string, timeout time.Duration) (*types.WorkspaceStatusResult, error) {
+	start := time.Now()
+	defer func() {
+		if s.metricsService != nil {
+			s.metricsService.RecordRequest("WaitWorkspaceStatus", "", 0, time.Since(start), 0)
+		}
+	}()
+
+	if timeout <= 0 {
+		timeout = DefaultStatusWaitTimeout
+	}
+	if timeout > MaxStatusWaitTimeout {
+		timeout = MaxStatusWaitTimeout
+	}
+
+	if err := s.verifyOwner(ctx, userID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	wsClient, err := s.workspaceCRDClient()
+	if err != nil {
+		return nil, apierrors.NewInternalError("workspace_get_failed", err)
+	}
+	crd, err := wsClient.Get(ctx, workspaceID, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			s.markDeleted(ctx, workspaceID)
+			return nil, apierrors.NewNotFoundError("workspace", workspaceID, err)
+		}
+		return nil, apierrors.NewInternalError("workspace_get_failed", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	timeoutSeconds := int64(timeout.Seconds()) + 1
+	w, err := wsClient.Watch(waitCtx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", workspaceID).String(),
+		ResourceVersion: crd.ResourceVersion,
+		TimeoutSeconds:  &timeoutSeconds,
+	})
+	if err != nil {
+		// Degrade to a plain status read rather than failing the request;
+		// the client simply polls again sooner.
+		s.logger.Warn("Status long-poll watch failed; returning current status",
+			"workspaceID", workspaceID, "error", err.Error())
+		return s.statusResultFromCRD(ctx, workspaceID, crd), nil
+	}
+	defer w.Stop()
+
+	latest := crd
+	for {
+		select {
+		case <-waitCtx.Done():
+			return s.statusResultFromCRD(ctx, workspaceID, latest), nil
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return s.statusResultFromCRD(ctx, workspaceID, latest), nil
+			}
+			switch event.Type {
+			case watch.Deleted:
+				s.markDeleted(ctx, workspaceID)
+				return nil, apierrors.NewNotFoundError("workspace", workspaceID, nil)
+			case watch.Added, watch.Modified:
+				updated, ok := event.Object.(*v1.Workspace)
+				if !ok {
+					continue
+				}
+				changed := workspaceStatusChanged(latest, updated)
+				latest = updated
+				if changed {
+					return s.statusResultFromCRD(ctx, workspaceID, latest), nil
+				}
+			case watch.Error:
+				return s.statusResultFromCRD(ctx, workspaceID, latest), nil
+			}
+		}
+	}
+}
+
+// workspaceStatusChanged reports whether a client-visible part of the
+// status moved. Heartbeat-only writes (health check timestamps, usage
+// gauges) do not count, or every long-poll would return within one
+// health-check interval.
+func workspaceStatusChanged(old, updated *v1.Workspace) bool {
+	if old.Status.Phase != updated.Status.Phase ||
+		old.Status.Message != updated.Status.Message ||
+		old.Status.FailureReason != updated.Status.FailureReason ||
+		old.Status.CreationProgress != updated.Status.CreationProgress {
+		return true
+	}
+	if len(old.Status.Conditions) != len(updated.Status.Conditions) {
+		return true
+	}
+	for i := range old.Status.Conditions {
+		o, u := old.Status.Conditions[i], updated.Status.Conditions[i]
+		if o.Type != u.Type || o.Status != u.Status || o.Reason != u.Reason {
+			return true
+		}
+	}
+	return false
+}
+
+// waitWorkspaceReady blocks until the just-created workspace reaches
+// Active or Failed, timeout elapses, or the workspace disappears, and
+// returns the latest observed object. Like WaitWorkspaceStatus it watches
+// from the create's resourceVersion, so a transition that lands before the
+// watch starts is still delivered. timeout is clamped the same way.
+// Creation has already succeeded, so a watch that cannot be opened is
+// logged and the created object returned unchanged rather than failing.
+func (s *Service) waitWorkspaceReady(ctx context.Context, created *v1.Workspace, timeout time.Duration) *v1.Workspace {
+	if timeout <= 0 {
+		timeout = DefaultStatusWaitTimeout
+	}
+	if timeout > MaxStatusWaitTimeout {
+		timeout = MaxStatusWaitTimeout
+	}
+	if workspaceSettled(created) {
+		return created
+	}
+
+	wsClient, err := s.workspaceCRDClient()
+	if err != nil {
+		s.logger.Warn("Ready wait: workspace client unavailable; returning current phase",
+			"workspaceID", created.Name, "error", err.Error())
+		return created
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	timeoutSeconds := int64(timeout.Seconds()) + 1
+	w, err := wsClient.Watch(waitCtx, metav1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", created.Name).String(),
+		ResourceVersion: created.ResourceVersion,
+		TimeoutSeconds:  &timeoutSeconds,
+	})
+	if err != nil {
+		s.logger.Warn("Ready wait: watch failed; returning current phase",
+			"workspaceID", created.Name, "error", err.Error())
+		return created
+	}
+	defer w.Stop()
+
+	latest := created
+	for {
+		select {
+		case <-waitCtx.Done():
+			return latest
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return latest
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				if updated, ok := event.Object.(*v1.Workspace); ok {
+					latest = updated
+					if workspaceSettled(latest) {
+						return latest
+					}
+				}
+			case watch.Deleted, watch.Error:
+				return latest
+			}
+		}
+	}
+}
+
+// workspaceSettled reports whether a creating workspace has stopped
+// progressing toward Active: it is either usable or has failed.
+func workspaceSettled(ws *v1.Workspace) bool {
+	return ws.Status.Phase == v1.WorkspacePhaseActive || ws.Status.Phase == v1.WorkspacePhaseFailed
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// === WaitWorkspaceStatus (long-poll) ===
+
+func setupStatusWait(t *testing.T, phase v1.WorkspacePhase) (*fixture, *v1.Workspace, *watch.FakeWatcher) {
+	t.Helper()
+	f := newFixture(t)
+	f.db.On("GetWorkspace", mock.Anything, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	crd := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	crd.ResourceVersion = "100"
+	crd.Status.Phase = phase
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+	fw := watch.NewFakeWithChanSize(4, false)
+	f.ws.On("Watch", mock.Anything, mock.MatchedBy(func(o metav1.ListOptions) bool {
+		return o.ResourceVersion == "100" && o.FieldSelector == "metadata.name=ws-1"
+	})).Return(fw, nil)
+	return f, crd, fw
+}
+
+func TestWaitWorkspaceStatus_PhaseChangeWakesWaiter(t *testing.T) {
+	f, crd, fw := setupStatusWait(t, v1.WorkspacePhaseCreating)
+
+	updated := crd.DeepCopy()
+	updated.ResourceVersion = "101"
+	updated.Status.Phase = v1.WorkspacePhaseActive
+	fw.Modify(updated)
+
+	start := time.Now()
+	result, err := f.svc.WaitWorkspaceStatus(context.Background(), "user1", "ws-1", 10*time.Second)
+
+	require.NoError(t, err)
+	assert.Equal(t, string(v1.WorkspacePhaseActive), result.Phase)
+	assert.Less(t, time.Since(start), 5*time.Second, "status change must return before the timeout")
+}
+
+func TestWaitWorkspaceStatus_HeartbeatOnlyUpdateKeepsWaiting(t *testing.T) {
+	f, crd, fw := setupStatusWait(t, v1.WorkspacePhaseActive)
+
+	heartbeat := crd.DeepCopy()
+	heartbeat.ResourceVersion = "101"
+	now := metav1.Now()
+	heartbeat.Status.LastHealthCheckAt = &now
+	fw.Modify(heartbeat)
+
+	start := time.Now()
+	result, err := f.svc.WaitWorkspaceStatus(context.Background(), "user1", "ws-1", 200*time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, string(v1.WorkspacePhaseActive), result.Phase)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond,
+		"a heartbeat-only write must not wake the long-poll")
+}
+
+func TestWaitWorkspaceStatus_TimeoutReturnsUnchangedStatus(t *testing.T) {
+	f, _, _ := setupStatusWait(t, v1.WorkspacePhaseSuspended)
+
+	result, err := f.svc.WaitWorkspaceStatus(context.Background(), "user1", "ws-1", 100*time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, string(v1.WorkspacePhaseSuspended), result.Phase)
+}
+
+func TestWaitWorkspaceStatus_DeletedReturnsNotFound(t *testing.T) {
+	f, crd, fw := setupStatusWait(t, v1.WorkspacePhaseTerminating)
+	f.db.On("MarkWorkspaceDeleted", mock.Anything, "ws-1").Maybe()
+	fw.Delete(crd)
+
+	_, err := f.svc.WaitWorkspaceStatus(context.Background(), "user1", "ws-1", 10*time.Second)
+
+	var apiErr *apierrors.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, apierrors.ErrorTypeNotFound, apiErr.Type)
+}
+
+func TestWaitWorkspaceStatus_WatchFailureFallsBackToCurrentStatus(t *testing.T) {
+	f := newFixture(t)
+	f.db.On("GetWorkspace", mock.Anything, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	crd := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	crd.Status.Phase = v1.WorkspacePhaseActive
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+	f.ws.On("Watch", mock.Anything, mock.Anything).Return(nil, errors.New("watch forbidden"))
+
+	result, err := f.svc.WaitWorkspaceStatus(context.Background(), "user1", "ws-1", 10*time.Second)
+
+	require.NoError(t, err)
+	assert.Equal(t, string(v1.WorkspacePhaseActive), result.Phase)
+}
+
+func TestWaitWorkspaceStatus_WrongUserForbidden(t *testing.T) {
+	f, _, _ := setupStatusWait(t, v1.WorkspacePhaseActive)
+
+	_, err := f.svc.WaitWorkspaceStatus(context.Background(), "intruder", "ws-1", time.Second)
+
+	assert.Error(t, err)
+	f.ws.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything)
+}
+
+func TestWorkspaceStatusChanged(t *testing.T) {
+	base := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	base.Status.Conditions = []v1.WorkspaceCondition{{Type: "Ready", Status: "False", Reason: "Starting"}}
+
+	same := base.DeepCopy()
+	same.Status.DiskUsedBytes = 42
+	assert.False(t, workspaceStatusChanged(base, same))
+
+	msg := base.DeepCopy()
+	msg.Status.Message = "pulling image"
+	assert.True(t, workspaceStatusChanged(base, msg))
+
+	cond := base.DeepCopy()
+	cond.Status.Conditions[0].Status = "True"
+	assert.True(t, workspaceStatusChanged(base, cond))
+
+	progress := base.DeepCopy()
+	progress.Status.CreationProgress = 50
+	assert.True(t, workspaceStatusChanged(base, progress))
+}
+
+// === CreateWorkspace waitUntilReady ===
+
+func setupReadyWait(t *testing.T) (*fixture, *v1.Workspace, *watch.FakeWatcher) {
+	t.Helper()
+	f := newFixture(t)
+	created := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	created.ResourceVersion = "100"
+	created.Status.Phase = v1.WorkspacePhasePending
+	f.ws.On("Create", mock.Anything, mock.Anything).Return(created, nil)
+	f.db.On("CreateWorkspace", mock.Anything, mock.Anything).Return(nil)
+	fw := watch.NewFakeWithChanSize(4, false)
+	f.ws.On("Watch", mock.Anything, mock.MatchedBy(func(o metav1.ListOptions) bool {
+		return o.ResourceVersion == "100" && o.FieldSelector == "metadata.name=ws-1"
+	})).Return(fw, nil)
+	return f, created, fw
+}
+
+func TestCreateWorkspace_WaitUntilReady_ReturnsWhenActive(t *testing.T) {
+	f, created, fw := setupReadyWait(t)
+
+	creating := created.DeepCopy()
+	creating.ResourceVersion = "101"
+	creating.Status.Phase = v1.WorkspacePhaseCreating
+	fw.Modify(creating)
+	active := created.DeepCopy()
+	active.ResourceVersion = "102"
+	active.Status.Phase = v1.WorkspacePhaseActive
+	fw.Modify(active)
+
+	start := time.Now()
+	ws, err := f.svc.CreateWorkspace(context.Background(), "user1", types.CreateWorkspaceRequest{
+		Name: "w", StorageSize: "10Gi", WaitUntilReady: true, ReadyTimeoutSeconds: 10,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, string(v1.WorkspacePhaseActive), ws.Phase)
+	require.NotNil(t, ws.Ready)
+	assert.True(t, *ws.Ready)
+	assert.Less(t, time.Since(start), 5*time.Second, "must return on Active, not at the timeout")
+}
+
+func TestCreateWorkspace_WaitUntilReady_TimeoutReturnsCurrentPhase(t *testing.T) {
+	f, created, fw := setupReadyWait(t)
+
+	creating := created.DeepCopy()
+	creating.ResourceVersion = "101"
+	creating.Status.Phase = v1.WorkspacePhaseCreating
+	fw.Modify(creating)
+
+	ws, err := f.svc.CreateWorkspace(context.Background(), "user1", types.CreateWorkspaceRequest{
+		Name: "w", StorageSize: "10Gi", WaitUntilReady: true, ReadyTimeoutSeconds: 1,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, string(v1.WorkspacePhaseCreating), ws.Phase)
+	require.NotNil(t, ws.Ready)
+	assert.False(t, *ws.Ready)
+}
+
+func TestCreateWorkspace_WithoutWait_DoesNotWatch(t *testing.T) {
+	f, _, _ := setupReadyWait(t)
+
+	ws, err := f.svc.CreateWorkspace(context.Background(), "user1", types.CreateWorkspaceRequest{Name: "w", StorageSize: "10Gi"})
+
+	require.NoError(t, err)
+	assert.Nil(t, ws.Ready)
+	f.ws.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything)
+}
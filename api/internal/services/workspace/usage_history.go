@@ -0,0 +1,145 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+const (
+	// DefaultUsageHistorySamples bounds the samples kept per workspace. The
+	// controller polls the agent every 15s, so this is roughly the last hour.
+	DefaultUsageHistorySamples = 240
+
+	// usageHistoryTTL expires a series that stops receiving samples, e.g.
+	// a suspended workspace or one deleted while no replica was watching.
+	usageHistoryTTL = 2 * time.Hour
+	// usageCheckClaimTTL only has to outlive the watch events of one
+	// health check across replicas.
+	usageCheckClaimTTL = 10 * time.Minute
+
+	usageSeriesKeyPref = "ws:usage:"
+	usageCheckKeyPref  = "ws:usage:check:"
+)
+
+// UsageCache is the subset of the cache service UsageHistory needs.
+type UsageCache interface {
+	SetNX(ctx context.Context, key string, value string, expiration time.Duration) (bool, error)
+	Delete(ctx context.Context, key string) error
+	GetObject(ctx context.Context, key string, value interface{}) error
+	SetObject(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+}
+
+// UsageHistory keeps a bounded series of recent CPU and memory samples per
+// workspace for GET /workspaces/:id/metrics. It is fed from the Workspace
+// watch: every health check the controller makes rewrites the
+// agent-reported usage fields and LastHealthCheckAt, and each new check
+// time becomes one sample. The series lives in the shared cache, so every
+// API replica serves the same history and it survives replica restarts.
+// Each replica runs its own watch; the first to claim a check records it.
+// Long-term usage lives in the controller's Prometheus metrics, not here.
+type UsageHistory struct {
+	cache      UsageCache
+	maxSamples int
+}
+
+// usageSeries is the cached value per workspace. LastCheckAt and
+// LastCPUMicros hold the previous cumulative CPU reading, needed to turn
+// the agent's counter into a rate.
+type usageSeries struct {
+	Samples       []types.WorkspaceUsageSample `json:"samples"`
+	LastCheckAt   time.Time                    `json:"lastCheckAt"`
+	LastCPUMicros int64                        `json:"lastCpuMicros"`
+}
+
+// NewUsageHistory returns a UsageHistory storing at most maxSamples per
+// workspace in cache; a non-positive value selects
+// DefaultUsageHistorySamples.
+func NewUsageHistory(cache UsageCache, maxSamples int) *UsageHistory {
+	if maxSamples <= 0 {
+		maxSamples = DefaultUsageHistorySamples
+	}
+	return &UsageHistory{cache: cache, maxSamples: maxSamples}
+}
+
+func usageSeriesKey(workspaceID string) string { return usageSeriesKeyPref + workspaceID }
+
+func usageCheckKey(workspaceID string, at time.Time) string {
+	return fmt.Sprintf("%s%s:%d", usageCheckKeyPref, workspaceID, at.UnixNano())
+}
+
+// Record appends a sample for ws when it carries a health check newer than
+// the last one recorded. A workspace's first check only establishes the
+// CPU baseline, as does any counter reset (a restarted pod): CPU is a rate
+// and needs two readings.
+func (h *UsageHistory) Record(ctx context.Context, ws *v1.Workspace) error {
+	if ws == nil || ws.Status.LastHealthCheckAt == nil || ws.Status.CpuUsageMicros <= 0 {
+		return nil
+	}
+	at := ws.Status.LastHealthCheckAt.Time
+	micros := ws.Status.CpuUsageMicros
+
+	// Every replica sees the same watch event; only one may append it.
+	claimed, err := h.cache.SetNX(ctx, usageCheckKey(ws.Name, at), "1", usageCheckClaimTTL)
+	if err != nil {
+		return fmt.Errorf("claiming health check: %w", err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	var series usageSeries
+	if err := h.cache.GetObject(ctx, usageSeriesKey(ws.Name), &series); err != nil {
+		return fmt.Errorf("reading usage history: %w", err)
+	}
+	seen := !series.LastCheckAt.IsZero()
+	if seen && !at.After(series.LastCheckAt) {
+		return nil // a status write that was not a new health check
+	}
+	elapsed := at.Sub(series.LastCheckAt).Microseconds()
+	if seen && micros >= series.LastCPUMicros && elapsed > 0 {
+		series.Samples = append(series.Samples, types.WorkspaceUsageSample{
+			Timestamp:          at,
+			CPUMillicores:      (micros - series.LastCPUMicros) * 1000 / elapsed,
+			CPULimitMillicores: ws.Status.CpuLimitMicrosPerSec / 1000,
+			MemoryUsedBytes:    ws.Status.MemoryUsedBytes,
+			MemoryTotalBytes:   ws.Status.MemoryTotalBytes,
+		})
+		if len(series.Samples) > h.maxSamples {
+			series.Samples = series.Samples[len(series.Samples)-h.maxSamples:]
+		}
+	}
+	series.LastCheckAt, series.LastCPUMicros = at, micros
+
+	if err := h.cache.SetObject(ctx, usageSeriesKey(ws.Name), series, usageHistoryTTL); err != nil {
+		return fmt.Errorf("writing usage history: %w", err)
+	}
+	return nil
+}
+
+// Samples returns workspaceID's samples, oldest first. The slice is empty,
+// not nil, when nothing has been recorded.
+func (h *UsageHistory) Samples(ctx context.Context, workspaceID string) ([]types.WorkspaceUsageSample, error) {
+	var series usageSeries
+	if err := h.cache.GetObject(ctx, usageSeriesKey(workspaceID), &series); err != nil {
+		return nil, fmt.Errorf("reading usage history: %w", err)
+	}
+	if series.Samples == nil {
+		return []types.WorkspaceUsageSample{}, nil
+	}
+	return series.Samples, nil
+}
+
+// Forget drops the series held for workspaceID.
+func (h *UsageHistory) Forget(ctx context.Context, workspaceID string) error {
+	if err := h.cache.Delete(ctx, usageSeriesKey(workspaceID)); err != nil {
+		return fmt.Errorf("deleting usage history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/lenaxia/llmsafespaces/api/internal/config"
+	"github.com/lenaxia/llmsafespaces/api/internal/logger"
+	"github.com/lenaxia/llmsafespaces/api/internal/services/cache"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+var usageT0 = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// newUsageCache returns the real cache service over an in-process Redis,
+// shared by every UsageHistory built on it like replicas share Redis.
+func newUsageCache(t *testing.T) *cache.Service {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	port, err := strconv.Atoi(mr.Port())
+	require.NoError(t, err)
+	cfg := &config.Config{}
+	cfg.Redis.Host, cfg.Redis.Port = mr.Host(), port
+	log, err := logger.New(true, "debug", "console")
+	require.NoError(t, err)
+	svc, err := cache.New(cfg, log)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = svc.Stop() })
+	return svc
+}
+
+func usageSamples(t *testing.T, h *UsageHistory, workspaceID string) []types.WorkspaceUsageSample {
+	t.Helper()
+	samples, err := h.Samples(context.Background(), workspaceID)
+	require.NoError(t, err)
+	return samples
+}
+
+func record(t *testing.T, h *UsageHistory, ws *v1.Workspace) {
+	t.Helper()
+	require.NoError(t, h.Record(context.Background(), ws))
+}
+
+// polledWorkspace is ws-1 as the controller leaves it after a health check
+// at usageT0+offset with the given cumulative CPU and memory readings.
+func polledWorkspace(offset time.Duration, cpuMicros, memBytes int64) *v1.Workspace {
+	checked := metav1.NewTime(usageT0.Add(offset))
+	return &v1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-1"},
+		Status: v1.WorkspaceStatus{
+			Phase:                v1.WorkspacePhaseActive,
+			LastHealthCheckAt:    &checked,
+			CpuUsageMicros:       cpuMicros,
+			CpuLimitMicrosPerSec: 2_000_000,
+			MemoryUsedBytes:      memBytes,
+			MemoryTotalBytes:     4 << 30,
+		},
+	}
+}
+
+func TestUsageHistory_SamplesCPURateAndMemory(t *testing.T) {
+	h := NewUsageHistory(newUsageCache(t), 0)
+
+	record(t, h, polledWorkspace(0, 10_000_000, 1<<30))
+	assert.Empty(t, usageSamples(t, h, "ws-1"), "the first poll only sets the CPU baseline")
+
+	// 7.5 CPU-seconds over 15s is half a core.
+	record(t, h, polledWorkspace(15*time.Second, 17_500_000, 2<<30))
+	samples := usageSamples(t, h, "ws-1")
+	require.Len(t, samples, 1)
+	assert.Equal(t, usageT0.Add(15*time.Second), samples[0].Timestamp)
+	assert.Equal(t, int64(500), samples[0].CPUMillicores)
+	assert.Equal(t, int64(2000), samples[0].CPULimitMillicores)
+	assert.Equal(t, int64(2<<30), samples[0].MemoryUsedBytes)
+	assert.Equal(t, int64(4<<30), samples[0].MemoryTotalBytes)
+
+	// A status write without a new health check adds nothing.
+	record(t, h, polledWorkspace(15*time.Second, 17_500_000, 3<<30))
+	assert.Len(t, usageSamples(t, h, "ws-1"), 1)
+}
+
+func TestUsageHistory_CounterResetRebaselines(t *testing.T) {
+	h := NewUsageHistory(newUsageCache(t), 0)
+	record(t, h, polledWorkspace(0, 50_000_000, 1<<30))
+	record(t, h, polledWorkspace(15*time.Second, 1_000_000, 1<<30)) // pod restarted
+	assert.Empty(t, usageSamples(t, h, "ws-1"), "a counter reset must not produce a negative rate")
+
+	record(t, h, polledWorkspace(30*time.Second, 16_000_000, 1<<30))
+	samples := usageSamples(t, h, "ws-1")
+	require.Len(t, samples, 1)
+	assert.Equal(t, int64(1000), samples[0].CPUMillicores)
+}
+
+func TestUsageHistory_BoundedPerWorkspace(t *testing.T) {
+	h := NewUsageHistory(newUsageCache(t), 3)
+	for i := 0; i <= 5; i++ {
+		record(t, h, polledWorkspace(time.Duration(i)*15*time.Second, int64(i+1)*1_000_000, int64(i)))
+	}
+	samples := usageSamples(t, h, "ws-1")
+	require.Len(t, samples, 3)
+	assert.Equal(t, []int64{3, 4, 5},
+		[]int64{samples[0].MemoryUsedBytes, samples[1].MemoryUsedBytes, samples[2].MemoryUsedBytes},
+		"the oldest samples are dropped first")
+}
+
+func TestUsageHistory_ReplicasShareOneSeries(t *testing.T) {
+	shared := newUsageCache(t)
+	a, b := NewUsageHistory(shared, 0), NewUsageHistory(shared, 0)
+
+	// Both replicas watch the same Workspace, so both see every check.
+	for i, micros := range []int64{1_000_000, 4_000_000, 7_000_000} {
+		ws := polledWorkspace(time.Duration(i)*15*time.Second, micros, 1<<30)
+		record(t, a, ws)
+		record(t, b, ws)
+	}
+
+	fromA, fromB := usageSamples(t, a, "ws-1"), usageSamples(t, b, "ws-1")
+	assert.Len(t, fromA, 2, "each health check is recorded once, not once per replica")
+	assert.Equal(t, fromA, fromB)
+
+	// A replica started later serves the same history.
+	assert.Equal(t, fromA, usageSamples(t, NewUsageHistory(shared, 0), "ws-1"))
+}
+
+func TestUsageHistory_IgnoresUnpolledWorkspaces(t *testing.T) {
+	h := NewUsageHistory(newUsageCache(t), 0)
+	record(t, h, nil)
+	record(t, h, &v1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "ws-1"}})
+	assert.Empty(t, usageSamples(t, h, "ws-1"))
+}
+
+func TestWorkspaceWatcher_FeedsUsageHistory(t *testing.T) {
+	k8s, _, _ := setupWatcherMocks(t)
+	w, err := NewWatcher(k8s, &testLogger{}, "default", func(*v1.Workspace) {})
+	require.NoError(t, err)
+	h := NewUsageHistory(newUsageCache(t), 0)
+	w.SetUsageHistory(h)
+	require.NoError(t, w.Start())
+	t.Cleanup(w.Stop)
+
+	w.handleEvent(watch.Event{Type: watch.Modified, Object: polledWorkspace(0, 1_000_000, 1)})
+	w.handleEvent(watch.Event{Type: watch.Modified, Object: polledWorkspace(15*time.Second, 2_000_000, 2)})
+	assert.Eventually(t, func() bool { return len(usageSamples(t, h, "ws-1")) == 1 },
+		5*time.Second, 10*time.Millisecond)
+
+	w.handleEvent(watch.Event{Type: watch.Deleted, Object: polledWorkspace(30*time.Second, 3_000_000, 3)})
+	assert.Eventually(t, func() bool { return len(usageSamples(t, h, "ws-1")) == 0 },
+		5*time.Second, 10*time.Millisecond, "a deleted workspace's history is dropped")
+}
+
+// stalledUsageCache never answers, like an unreachable Redis; each call
+// returns only when its context ends.
+type stalledUsageCache struct{ deadlines chan bool }
+
+func (c *stalledUsageCache) wait(ctx context.Context) error {
+	_, hasDeadline := ctx.Deadline()
+	c.deadlines <- hasDeadline
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (c *stalledUsageCache) SetNX(ctx context.Context, _, _ string, _ time.Duration) (bool, error) {
+	return false, c.wait(ctx)
+}
+func (c *stalledUsageCache) Delete(ctx context.Context, _ string) error { return c.wait(ctx) }
+func (c *stalledUsageCache) GetObject(ctx context.Context, _ string, _ interface{}) error {
+	return c.wait(ctx)
+}
+func (c *stalledUsageCache) SetObject(ctx context.Context, _ string, _ interface{}, _ time.Duration) error {
+	return c.wait(ctx)
+}
+
+func TestWorkspaceWatcher_StalledUsageCacheDoesNotDelayPhaseChanges(t *testing.T) {
+	k8s, _, _ := setupWatcherMocks(t)
+	phases := make(chan string, 4)
+	w, err := NewWatcher(k8s, &testLogger{}, "default", func(ws *v1.Workspace) {
+		phases <- string(ws.Status.Phase)
+	})
+	require.NoError(t, err)
+	stalled := &stalledUsageCache{deadlines: make(chan bool, 4)}
+	w.SetUsageHistory(NewUsageHistory(stalled, 0))
+	require.NoError(t, w.Start())
+	t.Cleanup(w.Stop)
+
+	creating := polledWorkspace(0, 1_000_000, 1)
+	creating.Status.Phase = v1.WorkspacePhaseCreating
+	w.handleEvent(watch.Event{Type: watch.Modified, Object: creating})
+	active := polledWorkspace(15*time.Second, 2_000_000, 2)
+	w.handleEvent(watch.Event{Type: watch.Modified, Object: active})
+
+	select {
+	case phase := <-phases:
+		assert.Equal(t, string(v1.WorkspacePhaseActive), phase)
+	case <-time.After(time.Second):
+		t.Fatal("phase change waited on the usage cache")
+	}
+	select {
+	case hasDeadline := <-stalled.deadlines:
+		assert.True(t, hasDeadline, "usage cache calls must be bounded by a timeout")
+	case <-time.After(time.Second):
+		t.Fatal("usage update was never applied")
+	}
+}
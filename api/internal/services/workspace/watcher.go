@@ -42,6 +42,22 @@ const (
 	watchBackoffMultiplier = 2
 )
 
+// Usage history is written to the shared cache off the watch loop so a
+// slow or unreachable cache cannot delay phase-change and version-sync
+// handling. Updates are applied in order by one goroutine; when it falls
+// behind, new updates are dropped (a lost sample is a gap in the graph,
+// a lost delete is left to the series TTL).
+const (
+	usageQueueSize    = 1024
+	usageCacheTimeout = 2 * time.Second
+)
+
+// usageUpdate is one watch event queued for the usage history.
+type usageUpdate struct {
+	workspace *v1.Workspace
+	deleted   bool
+}
+
 type Watcher struct {
 	k8sClient            pkginterfaces.KubernetesClient
 	logger               pkginterfaces.LoggerInterface
@@ -49,6 +65,8 @@ type Watcher struct {
 	onPhaseChange        PhaseChangeCallback
 	onVersionSync        VersionSyncCallback // nil-safe; set via SetVersionSyncCallback
 	userBroker           WorkspaceOwnerTracker
+	usageHistory         *UsageHistory // nil-safe; set via SetUsageHistory
+	usageQueue           chan usageUpdate
 	stopCh               chan struct{}
 	stopOnce             sync.Once
 	knownPhases          map[string]string
@@ -95,7 +113,19 @@ func (w *Watcher) SetVersionSyncCallback(cb VersionSyncCallback) {
 	w.onVersionSync = cb
 }
 
+// SetUsageHistory sets the store fed with each workspace's agent-reported
+// resource usage. Must be called before Start(), like SetUserBroker.
+func (w *Watcher) SetUsageHistory(h *UsageHistory) {
+	w.usageHistory = h
+	if h != nil {
+		w.usageQueue = make(chan usageUpdate, usageQueueSize)
+	}
+}
+
 func (w *Watcher) Start() error {
+	if w.usageHistory != nil {
+		go w.runUsageRecorder()
+	}
 	go w.runWatchLoop()
 	return nil
 }
@@ -313,6 +343,7 @@ func (w *Watcher) handleEvent(event watch.Event) {
 		if w.userBroker != nil {
 			w.userBroker.CleanupWorkspace(name)
 		}
+		w.queueUsage(usageUpdate{workspace: workspace, deleted: true})
 		return
 	}
 
@@ -352,6 +383,47 @@ func (w *Watcher) handleEvent(event watch.Event) {
 			w.knownImageTagsMu.Unlock()
 		}
 	}
+
+	w.queueUsage(usageUpdate{workspace: workspace})
+}
+
+// queueUsage hands u to runUsageRecorder without blocking the watch loop.
+func (w *Watcher) queueUsage(u usageUpdate) {
+	if w.usageQueue == nil {
+		return
+	}
+	select {
+	case w.usageQueue <- u:
+	default:
+		w.logger.Debug("Usage history queue full; dropping update", "workspace", u.workspace.Name)
+	}
+}
+
+// runUsageRecorder applies queued usage updates until Stop.
+func (w *Watcher) runUsageRecorder() {
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case u := <-w.usageQueue:
+			w.applyUsage(u)
+		}
+	}
+}
+
+func (w *Watcher) applyUsage(u usageUpdate) {
+	ctx, cancel := context.WithTimeout(context.Background(), usageCacheTimeout)
+	defer cancel()
+	name := u.workspace.Name
+	if u.deleted {
+		if err := w.usageHistory.Forget(ctx, name); err != nil {
+			w.logger.Warn("Failed to drop workspace usage history", "workspace", name, "error", err.Error())
+		}
+		return
+	}
+	if err := w.usageHistory.Record(ctx, u.workspace); err != nil {
+		w.logger.Warn("Failed to record workspace usage", "workspace", name, "error", err.Error())
+	}
 }
 
 func (w *Watcher) handleWatchError(status *metav1.Status) {
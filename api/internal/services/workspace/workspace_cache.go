@@ -0,0 +1,72 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"time"
+
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+const (
+	// defaultWorkspaceCacheTTL is how long a resolved workspace is served
+	// from the cache when Config.CacheTTL is zero. Short on purpose: the
+	// controller moves the phase without telling the API, so this bounds
+	// how stale a cached phase can be.
+	defaultWorkspaceCacheTTL = 5 * time.Second
+	workspaceCacheKeyPref    = "ws:resolved:"
+)
+
+func workspaceCacheKey(workspaceID string) string { return workspaceCacheKeyPref + workspaceID }
+
+// workspaceCacheTTL returns the TTL for resolved workspaces, or 0 when
+// caching is off (no cache service, or a negative Config.CacheTTL).
+func (s *Service) workspaceCacheTTL() time.Duration {
+	if s.cacheService == nil || s.config.CacheTTL < 0 {
+		return 0
+	}
+	if s.config.CacheTTL == 0 {
+		return defaultWorkspaceCacheTTL
+	}
+	return s.config.CacheTTL
+}
+
+// cachedWorkspace returns the resolved workspace cached by GetWorkspace,
+// or nil on a miss, when caching is off, or when ctx asks for a fresh
+// read. Only the metadata and CR lookups are cached; callers must still
+// check ownership so offboarding takes effect immediately.
+func (s *Service) cachedWorkspace(ctx context.Context, workspaceID string) *types.Workspace {
+	if s.workspaceCacheTTL() == 0 || types.FreshWorkspaceReadFromCtx(ctx) {
+		return nil
+	}
+	// A miss leaves the pointer nil (GetObject swallows redis.Nil).
+	var cached *types.Workspace
+	if err := s.cacheService.GetObject(ctx, workspaceCacheKey(workspaceID), &cached); err != nil {
+		return nil
+	}
+	return cached
+}
+
+func (s *Service) cacheWorkspace(ctx context.Context, ws *types.Workspace) {
+	ttl := s.workspaceCacheTTL()
+	if ttl == 0 {
+		return
+	}
+	if err := s.cacheService.SetObject(ctx, workspaceCacheKey(ws.ID), ws, ttl); err != nil {
+		s.logger.Debug("failed to cache workspace", "workspaceID", ws.ID, "error", err.Error())
+	}
+}
+
+// invalidateWorkspace drops the cached copy after the API changes a
+// workspace (rename, suspend, resume, restart, refresh, delete) so the
+// caller's next read reflects it. The cache is shared by all replicas.
+func (s *Service) invalidateWorkspace(ctx context.Context, workspaceID string) {
+	if s.cacheService == nil {
+		return
+	}
+	if err := s.cacheService.Delete(ctx, workspaceCacheKey(workspaceID)); err != nil {
+		s.logger.Warn("failed to invalidate cached workspace", "workspaceID", workspaceID, "error", err.Error())
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/lenaxia/llmsafespaces/api/internal/errors"
+	"github.com/lenaxia/llmsafespaces/pkg/types"
+)
+
+// cacheHolds makes the fixture's cache return ws for its key.
+func (f *fixture) cacheHolds(ws *types.Workspace) {
+	f.cache.ExpectedCalls = nil
+	f.cache.On("GetObject", mock.Anything, workspaceCacheKey(ws.ID), mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(2).(**types.Workspace) = ws }).Return(nil).Maybe()
+	f.cache.On("SetObject", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	f.cache.On("Delete", mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
+func TestGetWorkspace_CacheMissStoresResolvedWorkspace(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crdWorkspace("ws-1", "default", "user1", "10Gi"), nil)
+
+	_, err := f.svc.GetWorkspace(ctx, "user1", "ws-1")
+	require.NoError(t, err)
+
+	f.cache.AssertCalled(t, "SetObject", mock.Anything, "ws:resolved:ws-1",
+		mock.AnythingOfType("*types.Workspace"), defaultWorkspaceCacheTTL)
+}
+
+func TestGetWorkspace_CacheHitSkipsMetadataAndCRReads(t *testing.T) {
+	f := newFixture(t)
+	f.cacheHolds(&types.Workspace{ID: "ws-1", UserID: "user1", Name: "cached", Phase: "Active"})
+	ctx := context.WithValue(context.Background(), types.ContextKeyWorkspaceMeta,
+		dbWorkspace("ws-1", "user1", "my-ws", "10Gi"))
+
+	ws, err := f.svc.GetWorkspace(ctx, "user1", "ws-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "cached", ws.Name)
+	assert.Equal(t, "Active", ws.Phase)
+	f.ws.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	f.db.AssertNotCalled(t, "GetWorkspace", mock.Anything, mock.Anything)
+}
+
+func TestGetWorkspace_CacheHitStillChecksOwnership(t *testing.T) {
+	f := newFixture(t)
+	f.cacheHolds(&types.Workspace{ID: "ws-1", UserID: "user1", Name: "cached"})
+	ctx := context.Background()
+	// The workspace changed hands after it was cached.
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "other-user", "my-ws", "10Gi"), nil)
+
+	_, err := f.svc.GetWorkspace(ctx, "user1", "ws-1")
+
+	var apiErr *apierrors.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, apierrors.ErrorTypeForbidden, apiErr.Type)
+}
+
+func TestGetWorkspace_FreshReadBypassesCache(t *testing.T) {
+	f := newFixture(t)
+	f.cacheHolds(&types.Workspace{ID: "ws-1", UserID: "user1", Phase: "Creating"})
+	ctx := types.WithFreshWorkspaceRead(context.Background())
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	crd := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	crd.Status.Phase = "Active"
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+
+	ws, err := f.svc.GetWorkspace(ctx, "user1", "ws-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Active", ws.Phase)
+	f.cache.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetWorkspace_NegativeCacheTTLDisablesCache(t *testing.T) {
+	f := newFixture(t)
+	f.svc.config.CacheTTL = -time.Second
+	ctx := context.Background()
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crdWorkspace("ws-1", "default", "user1", "10Gi"), nil)
+
+	_, err := f.svc.GetWorkspace(ctx, "user1", "ws-1")
+
+	require.NoError(t, err)
+	f.cache.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+	f.cache.AssertNotCalled(t, "SetObject", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteWorkspace_InvalidatesCachedWorkspace(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	f.ws.On("Delete", mock.Anything, "ws-1", mock.Anything).Return(nil)
+	f.db.On("MarkWorkspaceDeleted", mock.Anything, "ws-1").Maybe()
+
+	require.NoError(t, f.svc.DeleteWorkspace(ctx, "user1", "ws-1"))
+
+	f.cache.AssertCalled(t, "Delete", mock.Anything, "ws:resolved:ws-1")
+}
+
+func TestSuspendWorkspace_InvalidatesCachedWorkspace(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	crd := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	crd.Status.Phase = "Active"
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+	f.ws.On("Update", mock.Anything, mock.Anything).Return(crd, nil)
+
+	require.NoError(t, f.svc.SuspendWorkspace(ctx, "user1", "ws-1"))
+
+	f.cache.AssertCalled(t, "Delete", mock.Anything, "ws:resolved:ws-1")
+}
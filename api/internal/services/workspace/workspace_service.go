@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -49,6 +50,7 @@ type Service struct {
 	instanceSettings  *settings.InstanceService
 	orgStore          OrgMembershipChecker
 	policyChecker     PolicyChecker
+	runtimes          *runtimeRegistry
 	config            *Config
 }
 
@@ -104,16 +106,15 @@ func (s *Service) workspaceCRDClient() (pkginterfaces.WorkspaceInterface, error)
 	return v1Client.Workspaces(s.config.Namespace), nil
 }
 
-// markDeleted soft-deletes a workspace metadata row in the background.
-// It accepts a context for symmetry with the caller and to silence
-// contextcheck, but deliberately does NOT propagate it: the caller is
-// typically a request goroutine whose context gets canceled as soon
-// as the HTTP response is flushed, which would race with the marker
+// markDeleted drops the cached workspace and soft-deletes its metadata
+// row in the background. ctx is used only for the synchronous cache
+// invalidation and deliberately NOT propagated to the row write: the
+// caller is typically a request goroutine whose context gets canceled as
+// soon as the HTTP response is flushed, which would race with the marker
 // write. context.Background inside the goroutine is correct and
-// intentional. The ctx parameter is currently unused; it exists so
-// future tracing/observability can be plumbed without changing every
-// call site.
-func (s *Service) markDeleted(_ context.Context, workspaceID string) {
+// intentional.
+func (s *Service) markDeleted(ctx context.Context, workspaceID string) {
+	s.invalidateWorkspace(ctx, workspaceID)
 	db := s.dbService
 	if db == nil {
 		return
@@ -129,6 +130,13 @@ func (s *Service) markDeleted(_ context.Context, workspaceID string) {
 type Config struct {
 	Namespace    string
 	OpencodePort int // Port for opencode on sandbox pods. Default: 4096.
+	// RuntimeCacheResync is the RuntimeEnvironment registry's informer
+	// resync period. Zero uses the default (10m); negative disables the
+	// cache so every runtime lookup reads the API server.
+	RuntimeCacheResync time.Duration
+	// CacheTTL is how long GetWorkspace serves a resolved workspace from
+	// the cache service. Zero uses the default (5s); negative disables it.
+	CacheTTL time.Duration
 }
 
 var _ apiinterfaces.WorkspaceService = (*Service)(nil)
@@ -171,11 +179,15 @@ func (s *Service) SetSessionIndex(si apiinterfaces.SessionIndexService) {
 
 func (s *Service) Start() error {
 	s.logger.Info("Starting workspace service")
+	s.startRuntimeRegistry()
 	return nil
 }
 
 func (s *Service) Stop() error {
 	s.logger.Info("Stopping workspace service")
+	if s.runtimes != nil {
+		s.runtimes.stop()
+	}
 	return nil
 }
 
@@ -209,6 +221,23 @@ func (s *Service) CreateWorkspace(ctx context.Context, userID string, req types.
 		)
 	}
 
+	if err := s.validatePriority(ctx, req.Priority); err != nil {
+		return nil, err
+	}
+	if err := s.validateRegion(ctx, req.Region); err != nil {
+		return nil, err
+	}
+	if err := s.validateTerminationGrace(ctx, req.TerminationGracePeriodSeconds); err != nil {
+		return nil, err
+	}
+	if err := validateLogLevel(req.LogLevel); err != nil {
+		return nil, err
+	}
+	sizedResources, err := s.resolveSize(ctx, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
 	// D4: workspace auto-attribution. When the user is in an org and did not
 	// supply OrgID, auto-attribute the workspace to their org. Users cannot
 	// create personal workspaces while part of an org. Non-org users get
@@ -283,9 +312,18 @@ func (s *Service) CreateWorkspace(ctx context.Context, userID string, req types.
 		}
 	}
 
+	runtimeEnv, err := s.lookupRuntimeEnvironment(ctx, req.Runtime)
+	if err != nil {
+		return nil, err
+	}
+
 	workspaceID := uuid.New().String()
 
 	crd := buildWorkspaceCRD(workspaceID, userID, req, s.config.Namespace)
+	crd.Spec.Resources = sizedResources
+	if crd.Spec.Resources == nil {
+		crd.Spec.Resources = s.runtimeDefaultResources(runtimeEnv)
+	}
 
 	// Apply defaults from instance settings to the CRD spec
 	s.applyWorkspaceDefaults(ctx, crd)
@@ -354,6 +392,10 @@ func (s *Service) CreateWorkspace(ctx context.Context, userID string, req types.
 	// container fetches them from the API via the bootstrap endpoint at pod
 	// boot. No action needed here.
 
+	if req.WaitUntilReady {
+		created = s.waitWorkspaceReady(ctx, created, time.Duration(req.ReadyTimeoutSeconds)*time.Second)
+	}
+
 	ws := &types.Workspace{
 		ID:          meta.ID,
 		Name:        meta.Name,
@@ -364,6 +406,10 @@ func (s *Service) CreateWorkspace(ctx context.Context, userID string, req types.
 		CreatedAt:   meta.CreatedAt,
 		UpdatedAt:   meta.UpdatedAt,
 	}
+	if req.WaitUntilReady {
+		ready := created.Status.Phase == v1.WorkspacePhaseActive
+		ws.Ready = &ready
+	}
 
 	return ws, nil
 }
@@ -377,13 +423,29 @@ func (s *Service) GetWorkspace(ctx context.Context, userID, workspaceID string)
 		}
 	}()
 
-	meta, err := s.dbService.GetWorkspace(ctx, workspaceID)
-	if err != nil {
-		s.logger.Error("Failed to retrieve workspace", err, "workspaceID", workspaceID)
-		return nil, apierrors.NewInternalError("workspace_retrieval_failed", err)
+	// A cached copy skips the metadata and CR reads but never the
+	// ownership check, which must reflect membership changes at once.
+	if cached := s.cachedWorkspace(ctx, workspaceID); cached != nil {
+		if err := s.verifyOwner(ctx, userID, workspaceID); err != nil {
+			return nil, err
+		}
+		return cached, nil
 	}
-	if meta == nil {
-		return nil, apierrors.NewNotFoundError("workspace", workspaceID, fmt.Errorf("workspace not found"))
+
+	// On /:id routes WorkspaceAccessMiddleware has already resolved and
+	// authorised this workspace for the request; reuse that row instead of
+	// a second DB read (verifyOwner short-circuits the same way).
+	meta, ok := types.WorkspaceMetaFromCtx(ctx)
+	if !ok || meta == nil || meta.ID != workspaceID {
+		var err error
+		meta, err = s.dbService.GetWorkspace(ctx, workspaceID)
+		if err != nil {
+			s.logger.Error("Failed to retrieve workspace", err, "workspaceID", workspaceID)
+			return nil, apierrors.NewInternalError("workspace_retrieval_failed", err)
+		}
+		if meta == nil {
+			return nil, apierrors.NewNotFoundError("workspace", workspaceID, fmt.Errorf("workspace not found"))
+		}
 	}
 	if err := s.verifyOwner(ctx, userID, workspaceID); err != nil {
 		return nil, err
@@ -421,12 +483,16 @@ func (s *Service) GetWorkspace(ctx context.Context, userID, workspaceID string)
 	if crd != nil {
 		ws.Phase = string(crd.Status.Phase)
 		ws.PVCName = crd.Status.PVCName
+		// Without the CR the phase is unknown; don't pin that.
+		s.cacheWorkspace(ctx, ws)
 	}
 
 	return ws, nil
 }
 
-// ListWorkspaces returns workspace metadata for a user with pagination.
+// ListWorkspaces returns workspace metadata for a user with pagination,
+// optionally filtered by phase and runtime and sorted as opts requests.
+// Unknown phases, sort fields and orders are validation errors.
 func (s *Service) ListWorkspaces(ctx context.Context, userID string, opts types.ListOptions) (*types.WorkspaceListResult, error) {
 	start := time.Now()
 	defer func() {
@@ -440,10 +506,9 @@ func (s *Service) ListWorkspaces(ctx context.Context, userID string, opts types.
 		limit = 20
 	}
 
-	metas, pagination, err := s.dbService.ListWorkspaces(ctx, userID, limit, opts.Offset)
+	filter, err := listFilter(opts)
 	if err != nil {
-		s.logger.Error("Failed to list workspaces", err, "userID", userID)
-		return nil, apierrors.NewInternalError("workspace_list_failed", err)
+		return nil, err
 	}
 
 	// Phase is owned by the Workspace CRD; the DB only stores immutable
@@ -451,9 +516,36 @@ func (s *Service) ListWorkspaces(ctx context.Context, userID string, opts types.
 	// On k8s error the items are returned with empty phase; the platform is
 	// already unusable in that scenario (every other operation hits the
 	// kube-apiserver too) so there's nothing meaningful to fall back to.
+	// A status filter is the exception: it cannot be answered without
+	// phases, so they are fetched first and resolved to IDs for the DB to
+	// paginate.
+	var phases map[string]string
+	if opts.Phase != "" {
+		phases = s.fetchUserWorkspacePhases(ctx, userID)
+		if phases == nil {
+			return nil, apierrors.NewInternalError("workspace_list_failed",
+				errors.New("workspace phases unavailable for status filter"))
+		}
+		filter.IDs = []string{}
+		for id, phase := range phases {
+			if phase == opts.Phase {
+				filter.IDs = append(filter.IDs, id)
+			}
+		}
+		sort.Strings(filter.IDs)
+	}
+
+	metas, pagination, err := s.dbService.ListWorkspacesFiltered(ctx, userID, filter, limit, opts.Offset)
+	if err != nil {
+		s.logger.Error("Failed to list workspaces", err, "userID", userID)
+		return nil, apierrors.NewInternalError("workspace_list_failed", err)
+	}
+
+	if phases == nil {
+		phases = s.fetchUserWorkspacePhases(ctx, userID)
+	}
 
 	items := make([]types.WorkspaceListItem, 0, len(metas))
-	phases := s.fetchUserWorkspacePhases(ctx, userID)
 	for _, m := range metas {
 		items = append(items, types.WorkspaceListItem{
 			ID:                      m.ID,
@@ -521,7 +613,12 @@ func (s *Service) DeleteWorkspace(ctx context.Context, userID, workspaceID strin
 	if err := s.verifyOwner(ctx, userID, workspaceID); err != nil {
 		return err
 	}
+	return s.deleteOwnedWorkspace(ctx, userID, workspaceID)
+}
 
+// deleteOwnedWorkspace deletes the CRD and soft-deletes the metadata row.
+// Callers must have verified ownership.
+func (s *Service) deleteOwnedWorkspace(ctx context.Context, userID, workspaceID string) error {
 	if err := func() error {
 		wsClient, wErr := s.workspaceCRDClient()
 		if wErr != nil {
@@ -592,6 +689,7 @@ func (s *Service) SuspendWorkspace(ctx context.Context, userID, workspaceID stri
 		s.logger.Error("Failed to set Spec.Suspend=true", err, "workspaceID", workspaceID)
 		return apierrors.NewInternalError("workspace_suspend_failed", err)
 	}
+	s.invalidateWorkspace(ctx, workspaceID)
 
 	s.logger.Info("Workspace suspend initiated", "workspaceID", workspaceID, "userID", userID)
 	return nil
@@ -703,6 +801,7 @@ func (s *Service) RestartWorkspace(ctx context.Context, userID, workspaceID stri
 		s.logger.Error("Failed to bump RestartGeneration", err, "workspaceID", workspaceID)
 		return apierrors.NewInternalError("workspace_restart_failed", err)
 	}
+	s.invalidateWorkspace(ctx, workspaceID)
 
 	s.logger.Info("Workspace restart initiated",
 		"workspaceID", workspaceID, "userID", userID,
@@ -785,6 +884,7 @@ func (s *Service) RefreshWorkspaceCompute(ctx context.Context, userID, workspace
 		s.logger.Error("Failed to refresh workspace compute", err, "workspaceID", workspaceID)
 		return nil, apierrors.NewInternalError("workspace_refresh_failed", err)
 	}
+	s.invalidateWorkspace(ctx, workspaceID)
 
 	// A suspended workspace has no pod; the restartGeneration bump is invisible
 	// to handleSuspended (it watches only spec.suspend). Resume so the
@@ -875,12 +975,21 @@ func (s *Service) GetWorkspaceStatus(ctx context.Context, userID, workspaceID st
 		return nil, apierrors.NewInternalError("workspace_get_failed", err)
 	}
 
+	return s.statusResultFromCRD(ctx, workspaceID, crd), nil
+}
+
+// statusResultFromCRD builds the status DTO from a fetched Workspace CRD.
+// Shared by GetWorkspaceStatus and WaitWorkspaceStatus.
+func (s *Service) statusResultFromCRD(ctx context.Context, workspaceID string, crd *v1.Workspace) *types.WorkspaceStatusResult {
 	result := &types.WorkspaceStatusResult{
 		Phase:          string(crd.Status.Phase),
 		PVCName:        crd.Status.PVCName,
 		ActiveSessions: int(crd.Status.ActiveSessions),
 		Message:        crd.Status.Message,
+		FailureReason:  string(crd.Status.FailureReason),
 		ImageTag:       crd.Status.ImageTag,
+
+		CreationProgress: int(crd.Status.CreationProgress),
 	}
 
 	// Fallback: if controller hasn't set ImageTag yet (pre-upgrade pods), read from pod spec
@@ -935,7 +1044,7 @@ func (s *Service) GetWorkspaceStatus(ctx context.Context, userID, workspaceID st
 		s.dbService.SyncWorkspaceVersionInfo(ctx, workspaceID, result.ImageTag, result.AgentHealth.AgentVersion)
 	}
 
-	return result, nil
+	return result
 }
 
 // ResolveWorkspace fetches workspace metadata by ID. It is the pure-fetch half
@@ -1064,7 +1173,14 @@ func buildWorkspaceCRD(workspaceID, userID string, req types.CreateWorkspaceRequ
 			Size:             req.StorageSize,
 			StorageClassName: req.StorageClass,
 		},
-		Runtime: req.Runtime,
+		Runtime:  req.Runtime,
+		Priority: v1.WorkspacePriority(req.Priority),
+		Region:   req.Region,
+
+		TerminationGracePeriodSeconds: req.TerminationGracePeriodSeconds,
+	}
+	if req.LogLevel != "" {
+		spec.Logging = &v1.WorkspaceLoggingConfig{Level: v1.AgentLogLevel(req.LogLevel)}
 	}
 
 	return &v1.Workspace{
@@ -1109,13 +1225,20 @@ func (s *Service) applyWorkspaceDefaults(ctx context.Context, crd *v1.Workspace)
 		}
 	}
 
-	// Resources
-	if crd.Spec.Resources == nil {
+	// Resources — per field, so a runtime recommendation that names only
+	// CPU or only memory still gets the platform default for the other.
+	if crd.Spec.Resources == nil || crd.Spec.Resources.CPU == "" || crd.Spec.Resources.Memory == "" {
 		cpu, _ := s.instanceSettings.GetString(ctx, settings.KeyWorkspaceDefaultResourcesCPU.Name())
 		mem, _ := s.instanceSettings.GetString(ctx, settings.KeyWorkspaceDefaultResourcesMemory.Name())
 		if cpu != "" || mem != "" {
-			crd.Spec.Resources = &v1.ResourceRequirements{
-				CPU: cpu, Memory: mem,
+			if crd.Spec.Resources == nil {
+				crd.Spec.Resources = &v1.ResourceRequirements{}
+			}
+			if crd.Spec.Resources.CPU == "" {
+				crd.Spec.Resources.CPU = cpu
+			}
+			if crd.Spec.Resources.Memory == "" {
+				crd.Spec.Resources.Memory = mem
 			}
 		}
 	}
@@ -1409,6 +1532,7 @@ func (s *Service) ActivateWorkspace(ctx context.Context, userID, workspaceID str
 			"workspaceID", workspaceID)
 		return nil, apierrors.NewInternalError("workspace_resume_failed", err)
 	}
+	s.invalidateWorkspace(ctx, workspaceID)
 
 	s.logger.Info("Workspace activated", "workspaceID", workspaceID, "userID", userID)
 	return &types.ActivateWorkspaceResponse{
@@ -1458,7 +1582,11 @@ func (s *Service) RenameWorkspace(ctx context.Context, userID, workspaceID, name
 	if err := s.verifyOwner(ctx, userID, workspaceID); err != nil {
 		return err
 	}
-	return s.dbService.UpdateWorkspace(ctx, workspaceID, types.WorkspaceUpdates{Name: &name})
+	if err := s.dbService.UpdateWorkspace(ctx, workspaceID, types.WorkspaceUpdates{Name: &name}); err != nil {
+		return err
+	}
+	s.invalidateWorkspace(ctx, workspaceID)
+	return nil
 }
 
 // RenameSession updates the title of a session in the session index.
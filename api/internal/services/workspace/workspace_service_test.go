@@ -32,6 +32,7 @@ type fixture struct {
 	k8s     *kmocks.MockKubernetesClient
 	v1iface *kmocks.MockLLMSafespacesV1Interface
 	ws      *kmocks.MockWorkspaceInterface
+	rte     *kmocks.MockRuntimeEnvironmentInterface
 	db      *imocks.MockDatabaseService
 	cache   *imocks.MockCacheService
 	metrics *imocks.MockMetricsService
@@ -52,20 +53,30 @@ func newFixture(t *testing.T) *fixture {
 	k8s := kmocks.NewMockKubernetesClient()
 	v1i := kmocks.NewMockLLMSafespacesV1Interface()
 	ws := kmocks.NewMockWorkspaceInterface()
+	rte := kmocks.NewMockRuntimeEnvironmentInterface()
 	db := &imocks.MockDatabaseService{}
 	cache := &imocks.MockCacheService{}
 	met := &imocks.MockMetricsService{}
 
 	met.On("RecordRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	// GetWorkspace's cache: every read misses unless a test says otherwise.
+	cache.On("GetObject", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	cache.On("SetObject", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	cache.On("Delete", mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	k8s.On("LlmsafespacesV1").Return(v1i, nil)
 	v1i.On("Workspaces", "default").Return(ws)
+	// CreateWorkspace resolves the runtime's RuntimeEnvironment; by default
+	// every runtime is installed and recommends no resources.
+	v1i.On("RuntimeEnvironments").Return(rte).Maybe()
+	rte.On("Get", mock.Anything, mock.Anything, mock.Anything).
+		Return(&v1.RuntimeEnvironment{ObjectMeta: metav1.ObjectMeta{Name: "runtime"}}, nil).Maybe()
 
 	svc, err := New(log, k8s, db, cache, met, &Config{Namespace: "default"})
 	if err != nil {
 		t.Fatalf("New() failed: %v", err)
 	}
-	return &fixture{svc: svc, k8s: k8s, v1iface: v1i, ws: ws, db: db, cache: cache, metrics: met, log: log}
+	return &fixture{svc: svc, k8s: k8s, v1iface: v1i, ws: ws, rte: rte, db: db, cache: cache, metrics: met, log: log}
 }
 
 // fixtureWithFakeClientset extends fixture with an in-memory K8s
@@ -346,6 +357,21 @@ func TestGetWorkspace_HappyPath(t *testing.T) {
 	assert.Equal(t, "user1", result.UserID)
 }
 
+func TestGetWorkspace_ReusesMiddlewareResolvedMeta(t *testing.T) {
+	f := newFixture(t)
+	// No f.db.On("GetWorkspace"): the metadata resolved by the access
+	// middleware must be reused, so a DB read would fail the mock.
+	ctx := context.WithValue(context.Background(), types.ContextKeyWorkspaceMeta,
+		dbWorkspace("ws-1", "user1", "my-ws", "10Gi"))
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crdWorkspace("ws-1", "default", "user1", "10Gi"), nil)
+
+	result, err := f.svc.GetWorkspace(ctx, "user1", "ws-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "my-ws", result.Name)
+	f.db.AssertNotCalled(t, "GetWorkspace", mock.Anything, mock.Anything)
+}
+
 func TestGetWorkspace_NotFound_ReturnsNotFound(t *testing.T) {
 	f := newFixture(t)
 	ctx := context.Background()
@@ -397,7 +423,7 @@ func TestListWorkspaces_HappyPath(t *testing.T) {
 		{ID: "ws-1", UserID: "user1", Name: "ws1", StorageSize: "10Gi", CreatedAt: now},
 		{ID: "ws-2", UserID: "user1", Name: "ws2", StorageSize: "5Gi", CreatedAt: now.Add(-time.Hour)},
 	}
-	f.db.On("ListWorkspaces", ctx, "user1", 10, 0).Return(metas, &types.PaginationMetadata{Total: 2, Limit: 10}, nil)
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{}, 10, 0).Return(metas, &types.PaginationMetadata{Total: 2, Limit: 10}, nil)
 
 	crdList := &v1.WorkspaceList{Items: []v1.Workspace{
 		{ObjectMeta: metav1.ObjectMeta{Name: "ws-1"}, Status: v1.WorkspaceStatus{Phase: v1.WorkspacePhaseActive}},
@@ -442,7 +468,7 @@ func TestListWorkspaces_PropagatesOrgID(t *testing.T) {
 		// short-circuits.
 		{ID: "ws-personal", UserID: "user1", Name: "personal", StorageSize: "5Gi", CreatedAt: now},
 	}
-	f.db.On("ListWorkspaces", ctx, "user1", 10, 0).Return(metas, &types.PaginationMetadata{Total: 2, Limit: 10}, nil)
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{}, 10, 0).Return(metas, &types.PaginationMetadata{Total: 2, Limit: 10}, nil)
 
 	crdList := &v1.WorkspaceList{Items: []v1.Workspace{
 		{ObjectMeta: metav1.ObjectMeta{Name: "ws-org"}, Status: v1.WorkspaceStatus{Phase: v1.WorkspacePhaseActive}},
@@ -476,7 +502,7 @@ func TestListWorkspaces_Empty_ReturnsEmptyList(t *testing.T) {
 	f := newFixture(t)
 	ctx := context.Background()
 
-	f.db.On("ListWorkspaces", ctx, "user1", 10, 0).Return([]*types.WorkspaceMetadata{}, &types.PaginationMetadata{Total: 0, Limit: 10}, nil)
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{}, 10, 0).Return([]*types.WorkspaceMetadata{}, &types.PaginationMetadata{Total: 0, Limit: 10}, nil)
 	f.ws.On("List", mock.Anything, metav1.ListOptions{LabelSelector: "user-id=user1"}).Return(&v1.WorkspaceList{}, nil)
 
 	result, err := f.svc.ListWorkspaces(ctx, "user1", types.ListOptions{Limit: 10, Offset: 0})
@@ -489,7 +515,7 @@ func TestListWorkspaces_DBFails_ReturnsInternal(t *testing.T) {
 	f := newFixture(t)
 	ctx := context.Background()
 
-	f.db.On("ListWorkspaces", ctx, "user1", 10, 0).Return(
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{}, 10, 0).Return(
 		([]*types.WorkspaceMetadata)(nil), (*types.PaginationMetadata)(nil), errors.New("db down"),
 	)
 
@@ -512,7 +538,7 @@ func TestListWorkspaces_K8sListFails_ReturnsItemsWithEmptyPhase(t *testing.T) {
 	metas := []*types.WorkspaceMetadata{
 		{ID: "ws-1", UserID: "user1", Name: "ws1", StorageSize: "10Gi", CreatedAt: now},
 	}
-	f.db.On("ListWorkspaces", ctx, "user1", 10, 0).Return(metas, &types.PaginationMetadata{Total: 1, Limit: 10}, nil)
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{}, 10, 0).Return(metas, &types.PaginationMetadata{Total: 1, Limit: 10}, nil)
 	f.ws.On("List", mock.Anything, metav1.ListOptions{LabelSelector: "user-id=user1"}).
 		Return((*v1.WorkspaceList)(nil), errors.New("apiserver down"))
 
@@ -533,7 +559,7 @@ func TestListWorkspaces_CRDMissing_PhaseEmpty(t *testing.T) {
 	metas := []*types.WorkspaceMetadata{
 		{ID: "ws-1", UserID: "user1", Name: "ws1", StorageSize: "10Gi", CreatedAt: now},
 	}
-	f.db.On("ListWorkspaces", ctx, "user1", 10, 0).Return(metas, &types.PaginationMetadata{Total: 1, Limit: 10}, nil)
+	f.db.On("ListWorkspacesFiltered", ctx, "user1", types.WorkspaceListFilter{}, 10, 0).Return(metas, &types.PaginationMetadata{Total: 1, Limit: 10}, nil)
 	f.ws.On("List", mock.Anything, metav1.ListOptions{LabelSelector: "user-id=user1"}).Return(&v1.WorkspaceList{}, nil)
 
 	result, err := f.svc.ListWorkspaces(ctx, "user1", types.ListOptions{Limit: 10, Offset: 0})
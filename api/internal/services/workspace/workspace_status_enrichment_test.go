@@ -112,3 +112,21 @@ func TestGetWorkspaceStatus_SessionsAndDiskTogether(t *testing.T) {
 	assert.Equal(t, int64(500_000), result.DiskUsedBytes)
 	assert.Equal(t, int64(1_000_000), result.DiskTotalBytes)
 }
+
+func TestGetWorkspaceStatus_IncludesFailureReason(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	f.db.On("GetWorkspace", ctx, "ws-1").Return(dbWorkspace("ws-1", "user1", "my-ws", "10Gi"), nil)
+	crd := crdWorkspace("ws-1", "default", "user1", "10Gi")
+	crd.Status.Phase = v1.WorkspacePhaseCreating
+	crd.Status.FailureReason = v1.FailureReasonImagePullFailed
+	crd.Status.Message = "Runtime image could not be pulled (ImagePullBackOff)"
+	f.ws.On("Get", mock.Anything, "ws-1", mock.Anything).Return(crd, nil)
+
+	result, err := f.svc.GetWorkspaceStatus(ctx, "user1", "ws-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ImagePullFailed", result.FailureReason)
+	assert.Equal(t, "Runtime image could not be pulled (ImagePullBackOff)", result.Message)
+}
@@ -939,12 +939,13 @@ func TestF132_LeasesAreNamespaceScoped(t *testing.T) {
 	require.True(t, sawLeases, "leases must be granted via a namespace-scoped Role")
 }
 
-// TestF134_APIDoesNotGrantRuntimeEnvironments asserts the API SA Role
-// does not include runtimeenvironments (unused per audit).
-func TestF134_APIDoesNotGrantRuntimeEnvironments(t *testing.T) {
+// TestF134_APIReadsRuntimeEnvironmentsClusterWide asserts the API SA's
+// namespace Role still does not grant runtimeenvironments (F1.3.4), and
+// that the cluster-scoped CRD is readable through a read-only ClusterRole
+// for the workspace service's RuntimeEnvironment registry.
+func TestF134_APIReadsRuntimeEnvironmentsClusterWide(t *testing.T) {
 	docs := helmTemplate(t, "")
-	roles := findResources(docs, "Role")
-	for _, role := range roles {
+	for _, role := range findResources(docs, "Role") {
 		name := metaName(role)
 		if !strings.Contains(name, "-api") {
 			continue
@@ -953,6 +954,15 @@ func TestF134_APIDoesNotGrantRuntimeEnvironments(t *testing.T) {
 		require.NotContains(t, rv, "llmsafespaces.dev/runtimeenvironments",
 			"API Role %q must NOT grant runtimeenvironments (F1.3.4)", name)
 	}
+
+	var verbs []string
+	for _, cr := range findResources(docs, "ClusterRole") {
+		if strings.HasSuffix(metaName(cr), "-api-runtimeenvironments") {
+			verbs = resourceVerbs(cr)["llmsafespaces.dev/runtimeenvironments"]
+		}
+	}
+	assert.ElementsMatch(t, []string{"get", "list", "watch"}, verbs,
+		"the API must read RuntimeEnvironments cluster-wide, and only read them")
 }
 
 // TestF135_APIDoesNotGrantPodsLog asserts the API SA Role does not
@@ -3622,3 +3632,46 @@ orgSubdomainRouting:
 	assert.Contains(t, stderr.String(), "baseDomain is required",
 		"error message must explain that baseDomain is required")
 }
+
+func TestControllerFlag_PriorityClasses(t *testing.T) {
+	docs := helmTemplate(t, "controller:\n  priorityClasses:\n    low: ws-low\n    high: ws-high\n")
+	args := findControllerArgs(t, docs)
+
+	var found string
+	for _, a := range args {
+		if strings.HasPrefix(a, "--priority-classes=") {
+			found = a
+			break
+		}
+	}
+	// Helm ranges over map keys in sorted order, so the rendering is stable.
+	require.Equal(t, "--priority-classes=high=ws-high,low=ws-low", found)
+}
+
+func TestControllerFlag_PriorityClassesAbsentByDefault(t *testing.T) {
+	docs := helmTemplate(t, "")
+	for _, a := range findControllerArgs(t, docs) {
+		require.False(t, strings.HasPrefix(a, "--priority-classes="),
+			"--priority-classes must NOT render when controller.priorityClasses is empty")
+	}
+}
+
+func TestControllerFlag_MaxConcurrentReconciles(t *testing.T) {
+	docs := helmTemplate(t, "controller:\n  maxConcurrentReconciles: 8\n")
+	require.Contains(t, findControllerArgs(t, docs), "--max-concurrent-reconciles=8")
+}
+
+func TestControllerFlag_UsageAlerts(t *testing.T) {
+	docs := helmTemplate(t, "controller:\n  usageAlerts:\n    webhookURL: https://alerts.example.com/hook\n    memoryThreshold: 0.8\n")
+	args := findControllerArgs(t, docs)
+	require.Contains(t, args, "--usage-alert-webhook-url=https://alerts.example.com/hook")
+	require.Contains(t, args, "--usage-alert-memory-threshold=0.8")
+	require.Contains(t, args, "--usage-alert-cooldown=30m")
+}
+
+func TestControllerFlag_UsageAlertsAbsentByDefault(t *testing.T) {
+	for _, a := range findControllerArgs(t, helmTemplate(t, "")) {
+		require.False(t, strings.HasPrefix(a, "--usage-alert-"),
+			"usage alert flags must NOT render without controller.usageAlerts.webhookURL")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/lenaxia/llmsafespaces/pkg/agentd"
 )
@@ -124,13 +125,26 @@ func main() {
 }
 
 func newLogger() *zap.Logger {
-	l, err := zap.NewProduction()
+	cfg := zap.NewProductionConfig()
+	cfg.Level = zap.NewAtomicLevelAt(logLevelFromEnv(os.Getenv("AGENTD_LOG_LEVEL")))
+	l, err := cfg.Build()
 	if err != nil {
 		return zap.NewNop()
 	}
 	return l
 }
 
+// logLevelFromEnv parses AGENTD_LOG_LEVEL (set by the controller from the
+// workspace's spec.logging.level). Empty or unrecognised values fall back
+// to info so a bad value never silences the agent.
+func logLevelFromEnv(v string) zapcore.Level {
+	lvl, err := zapcore.ParseLevel(v)
+	if err != nil || v == "" {
+		return zapcore.InfoLevel
+	}
+	return lvl
+}
+
 func readAgentPassword() string {
 	pw, err := os.ReadFile(agentd.PasswordPath)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/lenaxia/llmsafespaces/pkg/agentd"
 )
@@ -1071,3 +1072,18 @@ func TestStartRelayInjector_ExitsOnContextCancellation(t *testing.T) {
 	default:
 	}
 }
+
+func TestLogLevelFromEnv(t *testing.T) {
+	cases := map[string]zapcore.Level{
+		"":      zapcore.InfoLevel,
+		"debug": zapcore.DebugLevel,
+		"warn":  zapcore.WarnLevel,
+		"error": zapcore.ErrorLevel,
+		"bogus": zapcore.InfoLevel,
+	}
+	for in, want := range cases {
+		if got := logLevelFromEnv(in); got != want {
+			t.Errorf("logLevelFromEnv(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package common
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// BackoffJitterFraction is the largest share of a delay that Jitter removes.
+// Jitter only ever shortens a delay, so a capped backoff never exceeds its
+// cap.
+const BackoffJitterFraction = 0.2
+
+// Backoff returns base * 2^attempt, capped at limit, with Jitter applied.
+// attempt is zero-based; a negative attempt is treated as zero.
+func Backoff(attempt int, base, limit time.Duration) time.Duration {
+	if attempt < 0 {
+		attempt = 0
+	}
+	if attempt > 30 {
+		attempt = 30
+	}
+	d := base << attempt
+	if d > limit || d <= 0 {
+		d = limit
+	}
+	return Jitter(d)
+}
+
+// Jitter shortens d by a random amount of up to BackoffJitterFraction so
+// that objects which started waiting together (a burst of creates, a node
+// outage failing many workspaces at once) requeue spread out instead of in
+// lockstep.
+func Jitter(d time.Duration) time.Duration {
+	spread := int64(float64(d) * BackoffJitterFraction)
+	if spread <= 0 {
+		return d
+	}
+	return d - time.Duration(rand.Int64N(spread+1))
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_GrowsAndStaysWithinBounds(t *testing.T) {
+	base, limit := 2*time.Second, 30*time.Second
+	for attempt := -1; attempt <= 40; attempt++ {
+		want := limit
+		if attempt <= 3 {
+			want = base << max(attempt, 0)
+		}
+		for i := 0; i < 50; i++ {
+			got := Backoff(attempt, base, limit)
+			assert.LessOrEqual(t, got, want, "attempt %d", attempt)
+			assert.GreaterOrEqual(t, got, want-time.Duration(float64(want)*BackoffJitterFraction), "attempt %d", attempt)
+		}
+	}
+	assert.Greater(t, Backoff(3, base, limit), Backoff(0, base, limit),
+		"the lowest delay at attempt 3 must exceed the highest at attempt 0")
+}
+
+func TestJitter_Spreads(t *testing.T) {
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 100; i++ {
+		seen[Jitter(10*time.Second)] = true
+	}
+	assert.Greater(t, len(seen), 1, "jitter must not return a constant")
+	assert.Equal(t, time.Duration(0), Jitter(0))
+}
@@ -4,11 +4,18 @@
 package common
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 )
@@ -123,3 +130,55 @@ func TestGenerateRandomString_NotEmpty(t *testing.T) {
 	s := GenerateRandomString(16)
 	assert.NotEmpty(t, s)
 }
+
+// conflictingClient fails the first n status updates with a 409.
+func conflictingClient(t *testing.T, ws *v1.Workspace, n int) (client.Client, *int) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	updates := 0
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(ws).WithStatusSubresource(ws).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, sub string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				updates++
+				if updates <= n {
+					return apierrors.NewConflict(v1.Resource("workspaces"), obj.GetName(), nil)
+				}
+				return c.Status().Update(ctx, obj, opts...)
+			},
+		}).Build()
+	return c, &updates
+}
+
+func TestRetryOnConflict_SucceedsAfterTwoConflicts(t *testing.T) {
+	ctx := context.Background()
+	c, updates := conflictingClient(t, makeWorkspace(), 2)
+
+	ws := &v1.Workspace{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(makeWorkspace()), ws))
+	mutations := 0
+	err := RetryOnConflict(ctx, c, ws, func() error {
+		mutations++
+		ws.Status.CreationProgress = 50
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, *updates)
+	assert.Equal(t, 3, mutations, "mutate re-applies to each re-fetched copy")
+
+	stored := &v1.Workspace{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(ws), stored))
+	assert.Equal(t, int32(50), stored.Status.CreationProgress)
+}
+
+func TestRetryOnConflict_GivesUpAfterBoundedRetries(t *testing.T) {
+	ctx := context.Background()
+	c, updates := conflictingClient(t, makeWorkspace(), 1000)
+
+	ws := &v1.Workspace{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(makeWorkspace()), ws))
+	err := RetryOnConflict(ctx, c, ws, func() error { return nil })
+	assert.True(t, apierrors.IsConflict(err))
+	assert.Less(t, *updates, 10)
+}
@@ -4,6 +4,7 @@
 package common
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -97,3 +99,25 @@ func GenerateRandomString(length int) string {
 	}
 	return hex.EncodeToString(b)[:length]
 }
+
+// RetryOnConflict applies mutate to obj and writes its status subresource.
+// When the write hits a resourceVersion conflict, obj is re-fetched and
+// mutate is re-applied to the fresh copy, up to retry.DefaultRetry's step
+// count. mutate must only set status fields from values the caller already
+// computed; it may run more than once. On success obj is the persisted
+// object; after exhausting retries the last conflict error is returned.
+func RetryOnConflict(ctx context.Context, c client.Client, obj client.Object, mutate func() error) error {
+	refetch := false
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if refetch {
+			if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				return err
+			}
+		}
+		refetch = true
+		if err := mutate(); err != nil {
+			return err
+		}
+		return c.Status().Update(ctx, obj)
+	})
+}
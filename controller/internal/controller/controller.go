@@ -24,7 +24,7 @@ func init() {
 // status fetch per org per window).
 const orgStatusCacheTTL = 30 * time.Second
 
-func SetupControllers(mgr ctrl.Manager, inferenceRelayURL, inferenceRelaySecret, apiServiceURL, apiInternalToken, defaultRuntimeClass string) error {
+func SetupControllers(mgr ctrl.Manager, inferenceRelayURL, inferenceRelaySecret, apiServiceURL, apiInternalToken, defaultRuntimeClass string, priorityClasses map[string]string, maxConcurrentReconciles int, usageAlerts UsageAlertConfig) error {
 	logger := log.Log.WithName("controller")
 	logger.Info("Setting up controllers")
 
@@ -40,14 +40,27 @@ func SetupControllers(mgr ctrl.Manager, inferenceRelayURL, inferenceRelaySecret,
 		logger.Info("org-status suspension disabled (--api-service-url unset)")
 	}
 
+	var usageAlerter *workspace.UsageAlerter
+	if usageAlerts.WebhookURL != "" {
+		usageAlerter = workspace.NewUsageAlerter(usageAlerts.WebhookURL,
+			usageAlerts.MemoryThreshold, usageAlerts.DiskThreshold, usageAlerts.Cooldown, logger)
+		logger.Info("workspace usage alerts enabled",
+			"memoryThreshold", usageAlerts.MemoryThreshold, "diskThreshold", usageAlerts.DiskThreshold,
+			"cooldown", usageAlerts.Cooldown)
+	}
+
 	if err := (&workspace.WorkspaceReconciler{
-		Client:               mgr.GetClient(),
-		Scheme:               mgr.GetScheme(),
-		InferenceRelayURL:    inferenceRelayURL,
-		InferenceRelaySecret: inferenceRelaySecret,
-		OrgStatusClient:      orgStatusClient,
-		DefaultRuntimeClass:  defaultRuntimeClass,
-		APIServiceURL:        apiServiceURL,
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		InferenceRelayURL:       inferenceRelayURL,
+		InferenceRelaySecret:    inferenceRelaySecret,
+		OrgStatusClient:         orgStatusClient,
+		DefaultRuntimeClass:     defaultRuntimeClass,
+		PriorityClasses:         priorityClasses,
+		APIServiceURL:           apiServiceURL,
+		Recorder:                mgr.GetEventRecorderFor("workspace-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+		UsageAlerter:            usageAlerter,
 	}).SetupWithManager(mgr); err != nil {
 		logger.Error(err, "unable to create Workspace controller")
 		return err
@@ -56,6 +69,16 @@ func SetupControllers(mgr ctrl.Manager, inferenceRelayURL, inferenceRelaySecret,
 	return nil
 }
 
+// UsageAlertConfig configures workspace resource usage alerts. An empty
+// WebhookURL disables them. Thresholds are fractions (0.9 = 90%) of the
+// agent-reported total; <= 0 disables that resource.
+type UsageAlertConfig struct {
+	WebhookURL      string
+	MemoryThreshold float64
+	DiskThreshold   float64
+	Cooldown        time.Duration
+}
+
 // RelayArtifactConfig holds the relay-proxy binary distribution settings the
 // controller embeds into each relay VM's cloud-init. All fields are required
 // when the relay controller is enabled: a VM without a download path produces
@@ -75,7 +98,7 @@ type RelayArtifactConfig struct {
 // orphan detector (the periodic safety net that catches cloud VMs whose
 // owner CR has gone away). It is feature-gated and only activated when
 // enableRelay is true.
-func SetupRelayController(mgr ctrl.Manager, namespace, routerURL string, enableRelay bool, artifact RelayArtifactConfig) error {
+func SetupRelayController(mgr ctrl.Manager, namespace, routerURL string, enableRelay bool, artifact RelayArtifactConfig, maxConcurrentReconciles int) error {
 	if !enableRelay {
 		return nil
 	}
@@ -100,9 +123,10 @@ func SetupRelayController(mgr ctrl.Manager, namespace, routerURL string, enableR
 			"aws": "aws-relay-irwa",
 			"oci": "oci-credentials",
 		},
-		ArtifactURLs:        artifact.URLs,
-		ArtifactSHA256Arm64: artifact.SHA256Arm64,
-		ArtifactSHA256Amd64: artifact.SHA256Amd64,
+		ArtifactURLs:            artifact.URLs,
+		ArtifactSHA256Arm64:     artifact.SHA256Arm64,
+		ArtifactSHA256Amd64:     artifact.SHA256Amd64,
+		MaxConcurrentReconciles: maxConcurrentReconciles,
 	}
 
 	if err := relayReconciler.SetupWithManager(mgr); err != nil {
@@ -59,6 +59,9 @@ var (
 	WorkspacesInRecovery = prometheus.NewGauge(
 		prometheus.GaugeOpts{Name: "llmsafespaces_workspaces_in_recovery", Help: "Workspaces currently in recovery backoff (ConsecutiveFailures > 0 and not Active)"},
 	)
+	WorkspacesStuckTerminating = prometheus.NewGauge(
+		prometheus.GaugeOpts{Name: "llmsafespaces_workspaces_stuck_terminating", Help: "Workspaces whose deletion has been failing for longer than StuckTerminatingThreshold"},
+	)
 	WorkspaceRecoveryDurationSeconds = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "llmsafespaces_workspace_recovery_duration_seconds",
@@ -189,7 +192,7 @@ func AllCollectors() []prometheus.Collector {
 		WorkspaceRecoveryAttemptsTotal, WorkspaceRecoverySuccessTotal,
 		WorkspaceRecoveryBackoffDurationSeconds,
 		WorkspaceSafeModeActive, WorkspaceSafeModeEntriesTotal, WorkspaceSafeModeExitsTotal,
-		WorkspaceControllerRestartsTotal, WorkspacesInRecovery,
+		WorkspaceControllerRestartsTotal, WorkspacesInRecovery, WorkspacesStuckTerminating,
 		WorkspaceRecoveryDurationSeconds,
 		WorkspaceStatusUpdateConflictsTotal,
 		WorkspaceCreateDurationSeconds, WorkspaceResumeDurationSeconds,
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -105,6 +106,10 @@ type InferenceRelayReconciler struct {
 	// ArtifactSHA256Amd64 is the hex SHA-256 of the amd64 relay-proxy binary.
 	// Required when provisioning any amd64 shape (GCP e2, AWS t3).
 	ArtifactSHA256Amd64 string
+
+	// MaxConcurrentReconciles bounds how many InferenceRelay CRs are
+	// reconciled in parallel. 0 uses the controller-runtime default (1).
+	MaxConcurrentReconciles int
 }
 
 // Reconcile handles the InferenceRelay CR lifecycle.
@@ -837,6 +842,12 @@ func (r *InferenceRelayReconciler) handleDeletion(ctx context.Context, relay *v1
 	return ctrl.Result{}, nil
 }
 
+// controllerOptions returns the work-queue options applied in
+// SetupWithManager.
+func (r *InferenceRelayReconciler) controllerOptions() ctrlcontroller.Options {
+	return ctrlcontroller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}
+}
+
 // SetupWithManager registers the reconciler with the controller-runtime manager.
 //
 // Note: the relay-router-peers ConfigMap is intentionally NOT registered via
@@ -846,6 +857,7 @@ func (r *InferenceRelayReconciler) handleDeletion(ctx context.Context, relay *v1
 // the controller is the only legitimate writer.
 func (r *InferenceRelayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(r.controllerOptions()).
 		For(&v1.InferenceRelay{}).
 		Owns(&corev1.Secret{}).
 		Complete(r)
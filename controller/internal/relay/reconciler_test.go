@@ -831,3 +831,8 @@ relay_router_fallback_active 0
 	assert.Equal(t, string(v1.RelayStateUnhealthy), inst.State,
 		"a previously-healthy instance must flip to unhealthy when router reports unhealthy")
 }
+
+func TestControllerOptions_MaxConcurrentReconciles(t *testing.T) {
+	r := &InferenceRelayReconciler{MaxConcurrentReconciles: 4}
+	assert.Equal(t, 4, r.controllerOptions().MaxConcurrentReconciles)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
@@ -55,6 +56,14 @@ func (v *RuntimeEnvironmentValidator) Handle(ctx context.Context, req admission.
 		return admission.Denied("language is required")
 	}
 
+	switch runtimeEnv.Spec.ImagePullPolicy {
+	case "", corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+	default:
+		return admission.Denied(fmt.Sprintf(
+			"spec.imagePullPolicy %q is invalid; must be Always, IfNotPresent or Never",
+			runtimeEnv.Spec.ImagePullPolicy))
+	}
+
 	// F1.2.10 — same registry allow-list + traversal/whitespace
 	// checks as the workspace webhook (G2). The image MUST contain
 	// a slash (RuntimeEnvironment images are always explicit refs;
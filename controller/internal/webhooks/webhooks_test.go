@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -69,6 +70,23 @@ func TestRuntimeEnvironmentValidator_DeniesEmptyImage(t *testing.T) {
 	assert.Contains(t, resp.Result.Message, "image is required")
 }
 
+func TestRuntimeEnvironmentValidator_DeniesInvalidPullPolicy(t *testing.T) {
+	s := newScheme(t)
+	v := &RuntimeEnvironmentValidator{Decoder: admission.NewDecoder(s), AllowedImageRegistries: []string{"ghcr.io/"}}
+	re := &v1.RuntimeEnvironment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "llmsafespaces.dev/v1", Kind: "RuntimeEnvironment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "x"},
+		Spec:       v1.RuntimeEnvironmentSpec{Image: "ghcr.io/lenaxia/img", Language: "python", ImagePullPolicy: "Sometimes"},
+	}
+	resp := v.Handle(context.Background(), newAdmissionRequest(t, re))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "imagePullPolicy")
+
+	re.Spec.ImagePullPolicy = corev1.PullIfNotPresent
+	resp = v.Handle(context.Background(), newAdmissionRequest(t, re))
+	assert.True(t, resp.Allowed)
+}
+
 func TestRuntimeEnvironmentValidator_DeniesEmptyLanguage(t *testing.T) {
 	s := newScheme(t)
 	v := &RuntimeEnvironmentValidator{Decoder: admission.NewDecoder(s), AllowedImageRegistries: []string{"docker.io/", "ghcr.io/"}}
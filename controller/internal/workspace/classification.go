@@ -1,9 +1,12 @@
 package workspace
 
 import (
+	"fmt"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 )
 
 type FailureClass string
@@ -27,6 +30,10 @@ type PodObservation struct {
 	CrashLoop          bool
 	Unschedulable      bool
 	Scheduled          bool
+	// SchedulingMessage and ContainerMessage carry the scheduler's and
+	// kubelet's free-form detail for user-facing failure messages.
+	SchedulingMessage string
+	ContainerMessage  string
 }
 
 func classifyFailure(obs PodObservation) FailureClass {
@@ -82,6 +89,7 @@ func observePod(pod *corev1.Pod) PodObservation {
 				obs.Scheduled = true
 			} else if cond.Reason == "Unschedulable" {
 				obs.Unschedulable = true
+				obs.SchedulingMessage = cond.Message
 			}
 		}
 	}
@@ -89,6 +97,7 @@ func observePod(pod *corev1.Pod) PodObservation {
 		if cs.State.Waiting != nil {
 			if obs.ContainerReason == "" {
 				obs.ContainerReason = cs.State.Waiting.Reason
+				obs.ContainerMessage = cs.State.Waiting.Message
 			}
 			if cs.State.Waiting.Reason == "CrashLoopBackOff" {
 				obs.CrashLoop = true
@@ -97,6 +106,7 @@ func observePod(pod *corev1.Pod) PodObservation {
 		if cs.State.Terminated != nil {
 			if obs.ContainerReason == "" {
 				obs.ContainerReason = cs.State.Terminated.Reason
+				obs.ContainerMessage = cs.State.Terminated.Message
 			}
 			obs.ContainerExitCode = cs.State.Terminated.ExitCode
 			if cs.State.Terminated.Reason == "OOMKilled" {
@@ -107,6 +117,7 @@ func observePod(pod *corev1.Pod) PodObservation {
 	for _, cs := range pod.Status.InitContainerStatuses {
 		if cs.State.Waiting != nil && obs.ContainerReason == "" {
 			obs.ContainerReason = cs.State.Waiting.Reason
+			obs.ContainerMessage = cs.State.Waiting.Message
 		}
 		if cs.State.Terminated != nil {
 			if cs.State.Terminated.Reason == "OOMKilled" {
@@ -119,3 +130,76 @@ func observePod(pod *corev1.Pod) PodObservation {
 	}
 	return obs
 }
+
+// describeFailure maps a pod observation to the typed FailureReason and
+// human-readable Message surfaced on WorkspaceStatus. Checks run in the
+// same priority order as classifyFailure so the reason agrees with the
+// recovery class.
+func describeFailure(obs PodObservation) (v1.FailureReason, string) {
+	if !obs.Exists {
+		return v1.FailureReasonTransientPodLoss, "Workspace pod disappeared; recreating"
+	}
+	if obs.ContainerOOMKilled {
+		return v1.FailureReasonOOMKilled, "Workspace container ran out of memory (OOMKilled); consider a larger memory limit"
+	}
+	if obs.Reason == "Evicted" {
+		return v1.FailureReasonEvicted, withDetail("Workspace pod was evicted from its node", obs.Message)
+	}
+	if obs.Unschedulable {
+		if strings.Contains(obs.SchedulingMessage, "Insufficient") {
+			return v1.FailureReasonInsufficientResources,
+				withDetail("No node has enough free resources for this workspace", obs.SchedulingMessage)
+		}
+		return v1.FailureReasonUnschedulable, withDetail("Workspace pod cannot be scheduled", obs.SchedulingMessage)
+	}
+	switch obs.ContainerReason {
+	case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull":
+		return v1.FailureReasonImagePullFailed,
+			withDetail(fmt.Sprintf("Runtime image could not be pulled (%s)", obs.ContainerReason), obs.ContainerMessage)
+	case "CreateContainerConfigError", "CreateContainerError":
+		return v1.FailureReasonContainerConfigError,
+			withDetail(fmt.Sprintf("Workspace container could not be created (%s)", obs.ContainerReason), obs.ContainerMessage)
+	case "CrashLoopBackOff", "RunContainerError", "StartError",
+		"ContainerCannotRun", "PostStartHookError", "BackOff", "Error":
+		msg := fmt.Sprintf("Workspace container crashed (%s", obs.ContainerReason)
+		if obs.ContainerExitCode != 0 {
+			msg += fmt.Sprintf(", exit code %d", obs.ContainerExitCode)
+		}
+		return v1.FailureReasonContainerCrashed, withDetail(msg+")", obs.ContainerMessage)
+	}
+	if obs.CrashLoop {
+		return v1.FailureReasonContainerCrashed, "Workspace container is crash-looping"
+	}
+	return v1.FailureReasonPodFailedDuringCreation, withDetail("Workspace pod failed", obs.Message)
+}
+
+// startupProblem reports whether a still-Pending pod is stuck on a cause
+// the kubelet or scheduler will not resolve by itself soon (bad image,
+// broken container config, no schedulable node). Plain slow starts
+// (image still pulling, init containers running) return false.
+func startupProblem(obs PodObservation) bool {
+	if obs.Unschedulable {
+		return true
+	}
+	switch obs.ContainerReason {
+	case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull",
+		"CreateContainerConfigError", "CreateContainerError":
+		return true
+	}
+	return false
+}
+
+// maxFailureDetailLen caps kubelet/scheduler detail appended to status
+// messages; image pull errors can embed multi-KB registry responses.
+const maxFailureDetailLen = 256
+
+func withDetail(summary, detail string) string {
+	detail = strings.TrimSpace(detail)
+	if detail == "" {
+		return summary
+	}
+	if len(detail) > maxFailureDetailLen {
+		detail = detail[:maxFailureDetailLen] + "..."
+	}
+	return summary + ": " + detail
+}
@@ -1,10 +1,13 @@
 package workspace
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 )
 
 func TestClassifyFailure_PodNotFound(t *testing.T) {
@@ -162,3 +165,80 @@ func TestObservePod_OOMKilledPod(t *testing.T) {
 	assert.True(t, obs.ContainerOOMKilled)
 	assert.Equal(t, int32(137), obs.ContainerExitCode)
 }
+
+func TestDescribeFailure(t *testing.T) {
+	tests := []struct {
+		name       string
+		obs        PodObservation
+		wantReason v1.FailureReason
+		wantInMsg  string
+	}{
+		{"pod missing", PodObservation{Exists: false}, v1.FailureReasonTransientPodLoss, "disappeared"},
+		{"oom", PodObservation{Exists: true, ContainerOOMKilled: true, ContainerReason: "OOMKilled"}, v1.FailureReasonOOMKilled, "out of memory"},
+		{"evicted", PodObservation{Exists: true, Reason: "Evicted", Message: "The node was low on resource: memory."}, v1.FailureReasonEvicted, "low on resource"},
+		{
+			"insufficient resources",
+			PodObservation{Exists: true, Unschedulable: true, SchedulingMessage: "0/3 nodes are available: 3 Insufficient memory."},
+			v1.FailureReasonInsufficientResources, "3 Insufficient memory",
+		},
+		{
+			"unschedulable taints",
+			PodObservation{Exists: true, Unschedulable: true, SchedulingMessage: "0/3 nodes are available: 3 node(s) had untolerated taint."},
+			v1.FailureReasonUnschedulable, "untolerated taint",
+		},
+		{
+			"image pull backoff",
+			PodObservation{Exists: true, ContainerReason: "ImagePullBackOff", ContainerMessage: `Back-off pulling image "ghcr.io/x/y:bad"`},
+			v1.FailureReasonImagePullFailed, "ghcr.io/x/y:bad",
+		},
+		{"err image pull", PodObservation{Exists: true, ContainerReason: "ErrImagePull"}, v1.FailureReasonImagePullFailed, "ErrImagePull"},
+		{
+			"config error",
+			PodObservation{Exists: true, ContainerReason: "CreateContainerConfigError", ContainerMessage: `secret "x" not found`},
+			v1.FailureReasonContainerConfigError, `secret "x" not found`,
+		},
+		{"crash loop", PodObservation{Exists: true, ContainerReason: "CrashLoopBackOff"}, v1.FailureReasonContainerCrashed, "CrashLoopBackOff"},
+		{"exit code", PodObservation{Exists: true, ContainerReason: "Error", ContainerExitCode: 137}, v1.FailureReasonContainerCrashed, "exit code 137"},
+		{"unknown", PodObservation{Exists: true, Phase: corev1.PodFailed}, v1.FailureReasonPodFailedDuringCreation, "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, msg := describeFailure(tt.obs)
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Contains(t, msg, tt.wantInMsg)
+		})
+	}
+}
+
+func TestDescribeFailure_TruncatesLongDetail(t *testing.T) {
+	obs := PodObservation{Exists: true, ContainerReason: "ErrImagePull", ContainerMessage: strings.Repeat("x", 4096)}
+	_, msg := describeFailure(obs)
+	assert.Less(t, len(msg), 400)
+	assert.True(t, strings.HasSuffix(msg, "..."))
+}
+
+func TestObservePod_CapturesFailureDetail(t *testing.T) {
+	pod := &corev1.Pod{Status: corev1.PodStatus{
+		Phase: corev1.PodPending,
+		Conditions: []corev1.PodCondition{{
+			Type: corev1.PodScheduled, Status: corev1.ConditionFalse,
+			Reason: "Unschedulable", Message: "0/1 nodes are available: 1 Insufficient cpu.",
+		}},
+		ContainerStatuses: []corev1.ContainerStatus{{
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+				Reason: "ImagePullBackOff", Message: "Back-off pulling image",
+			}},
+		}},
+	}}
+	obs := observePod(pod)
+	assert.Equal(t, "0/1 nodes are available: 1 Insufficient cpu.", obs.SchedulingMessage)
+	assert.Equal(t, "Back-off pulling image", obs.ContainerMessage)
+}
+
+func TestStartupProblem(t *testing.T) {
+	assert.True(t, startupProblem(PodObservation{Exists: true, ContainerReason: "ImagePullBackOff"}))
+	assert.True(t, startupProblem(PodObservation{Exists: true, Unschedulable: true}))
+	assert.True(t, startupProblem(PodObservation{Exists: true, ContainerReason: "CreateContainerConfigError"}))
+	assert.False(t, startupProblem(PodObservation{Exists: true, ContainerReason: "ContainerCreating"}))
+	assert.False(t, startupProblem(PodObservation{Exists: true, ContainerReason: "PodInitializing"}))
+}
@@ -34,9 +34,14 @@ const podNameSuffix = 8
 // requeueActive is the cadence at which a healthy Active workspace is
 // re-reconciled to refresh egress NetworkPolicies, accumulate billing
 // metrics, and run health checks. Independent of cold-start latency.
+//
+// requeueCreatingMax caps the Creating-phase poll for a pod that has been
+// starting for several minutes (slow image pull, pending scheduling); see
+// creatingPollInterval.
 const (
-	requeueCreating = 2 * time.Second
-	requeueActive   = 15 * time.Second
+	requeueCreating    = 2 * time.Second
+	requeueCreatingMax = 30 * time.Second
+	requeueActive      = 15 * time.Second
 )
 
 // pendingPhaseTimeout is how long a workspace can stay in Pending before
@@ -676,3 +676,11 @@ func TestReconcile_Creating_WithPriorFailures_RecoverySuccessMetricFires(t *test
 	assert.Equal(t, v1.WorkspacePhaseActive, updated.Status.Phase)
 	assert.Greater(t, readCounter(), before, "WorkspaceRecoverySuccessTotal must increment on Creating→Active with prior failures")
 }
+
+func TestControllerOptions_MaxConcurrentReconciles(t *testing.T) {
+	r := &WorkspaceReconciler{MaxConcurrentReconciles: 8}
+	assert.Equal(t, 8, r.controllerOptions().MaxConcurrentReconciles)
+
+	// Zero leaves the controller-runtime default (1) in effect.
+	assert.Equal(t, 0, (&WorkspaceReconciler{}).controllerOptions().MaxConcurrentReconciles)
+}
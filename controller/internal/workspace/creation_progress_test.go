@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreationProgress_PodLifecycle(t *testing.T) {
+	scheduled := corev1.PodCondition{Type: corev1.PodScheduled, Status: corev1.ConditionTrue}
+	initialized := corev1.PodCondition{Type: corev1.PodInitialized, Status: corev1.ConditionTrue}
+	initRunning := corev1.ContainerStatus{Name: "workspace-setup", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}
+	initPulling := corev1.ContainerStatus{Name: "workspace-setup", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}}}
+
+	cases := []struct {
+		name string
+		pod  *corev1.Pod
+		want int32
+	}{
+		{"no pod", nil, 0},
+		{"pending, unscheduled", &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}, 0},
+		{"scheduled, pulling init image", &corev1.Pod{Status: corev1.PodStatus{
+			Phase:                 corev1.PodPending,
+			Conditions:            []corev1.PodCondition{scheduled},
+			InitContainerStatuses: []corev1.ContainerStatus{initPulling},
+		}}, 25},
+		{"init container running", &corev1.Pod{Status: corev1.PodStatus{
+			Phase:                 corev1.PodPending,
+			Conditions:            []corev1.PodCondition{scheduled},
+			InitContainerStatuses: []corev1.ContainerStatus{initRunning},
+		}}, 50},
+		{"initialized, containers starting", &corev1.Pod{Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			Conditions:        []corev1.PodCondition{scheduled, initialized},
+			ContainerStatuses: []corev1.ContainerStatus{{Ready: false}},
+		}}, 75},
+		{"running and ready", &corev1.Pod{Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			Conditions:        []corev1.PodCondition{scheduled, initialized},
+			ContainerStatuses: []corev1.ContainerStatus{{Ready: true}},
+		}}, 100},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := creationProgress(tc.pod); got != tc.want {
+				t.Errorf("creationProgress = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCreationProgress_ScheduledFalseIsPending(t *testing.T) {
+	pod := &corev1.Pod{Status: corev1.PodStatus{
+		Phase:      corev1.PodPending,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: "Unschedulable"}},
+	}}
+	if got := creationProgress(pod); got != 0 {
+		t.Errorf("unschedulable pod: creationProgress = %d, want 0", got)
+	}
+}
+
+func TestCreatingPollInterval_BacksOffForSlowPods(t *testing.T) {
+	podAged := func(age time.Duration) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Now().Add(-age))}}
+	}
+
+	fresh := creatingPollInterval(podAged(10 * time.Second))
+	assert.LessOrEqual(t, fresh, requeueCreating, "cold starts keep the fast poll")
+	assert.Greater(t, creatingPollInterval(podAged(3*time.Minute)), requeueCreating)
+	assert.LessOrEqual(t, creatingPollInterval(podAged(time.Hour)), requeueCreatingMax)
+	assert.LessOrEqual(t, creatingPollInterval(&corev1.Pod{}), requeueCreating)
+}
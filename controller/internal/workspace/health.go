@@ -350,6 +350,7 @@ func (r *WorkspaceReconciler) enrichAgentStatus(ctx context.Context, ws *v1.Work
 		ws.Status.ContextUsed = status.Context.UsedTokens
 		ws.Status.ContextTotal = status.Context.TotalTokens
 	}
+	r.UsageAlerter.Evaluate(ws)
 
 	r.setCondition(ws, v1.WorkspaceConditionAgentHealthy, "True",
 		v1.ReasonAgentHealthy, fmt.Sprintf("connected=%v sessions=%d version=%s",
@@ -119,6 +119,8 @@ func (r *WorkspaceReconciler) handleActive(ctx context.Context, workspace *v1.Wo
 		runtime := workspace.Spec.Runtime
 		secLevel := string(workspace.Spec.SecurityLevel)
 		metrics.WorkspacesRunning.WithLabelValues(runtime, secLevel).Dec()
+		reason, msg := describeFailure(PodObservation{Exists: false})
+		r.setFailure(workspace, reason, msg)
 		result, err := r.enterRecovery(ctx, workspace, FailureClassInfrastructure)
 		if err != nil {
 			metrics.WorkspacesRunning.WithLabelValues(runtime, secLevel).Inc()
@@ -149,6 +151,8 @@ func (r *WorkspaceReconciler) handleActive(ctx context.Context, workspace *v1.Wo
 	if pod.Status.Phase != corev1.PodRunning {
 		obs := observePod(pod)
 		class := classifyFailure(obs)
+		reason, msg := describeFailure(obs)
+		r.setFailure(workspace, reason, msg)
 		runtime := workspace.Spec.Runtime
 		secLevel := string(workspace.Spec.SecurityLevel)
 		metrics.WorkspacesRunning.WithLabelValues(runtime, secLevel).Dec()
@@ -188,6 +192,8 @@ func (r *WorkspaceReconciler) handleActive(ctx context.Context, workspace *v1.Wo
 		if cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
 			obs := observePod(pod)
 			class := classifyFailure(obs)
+			reason, msg := describeFailure(obs)
+			r.setFailure(workspace, reason, msg)
 			runtime := workspace.Spec.Runtime
 			secLevel := string(workspace.Spec.SecurityLevel)
 			metrics.WorkspacesRunning.WithLabelValues(runtime, secLevel).Dec()
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/lenaxia/llmsafespaces/controller/internal/common"
 	"github.com/lenaxia/llmsafespaces/controller/internal/metrics"
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 )
@@ -106,6 +107,7 @@ func (r *WorkspaceReconciler) handleCreating(ctx context.Context, workspace *v1.
 		pod, buildErr := r.buildPod(ctx, workspace)
 		if buildErr != nil {
 			logger.Error(buildErr, "Failed to build pod")
+			r.setFailure(workspace, v1.FailureReasonPodBuildFailed, withDetail("Workspace pod could not be built", buildErr.Error()))
 			return r.enterRecovery(ctx, workspace, FailureClassConfiguration)
 		}
 		if err := controllerutil.SetControllerReference(workspace, pod, r.Scheme); err != nil {
@@ -119,6 +121,7 @@ func (r *WorkspaceReconciler) handleCreating(ctx context.Context, workspace *v1.
 		}
 		workspace.Status.PodName = pod.Name
 		workspace.Status.PodNamespace = pod.Namespace
+		workspace.Status.CreationProgress = 0
 		if err := r.Status().Update(ctx, workspace); err != nil {
 			recordStatusUpdateConflictOnError("handleCreating_pod_built", err)
 			return ctrl.Result{}, err
@@ -170,6 +173,8 @@ func (r *WorkspaceReconciler) handleCreating(ctx context.Context, workspace *v1.
 		workspace.Status.Endpoint = fmt.Sprintf("http://%s:4096", existingPod.Status.PodIP)
 		workspace.Status.StartTime = &now
 		workspace.Status.Message = ""
+		workspace.Status.FailureReason = v1.FailureReasonNone
+		workspace.Status.CreationProgress = 100
 		if err := r.Status().Update(ctx, workspace); err != nil {
 			recordStatusUpdateConflictOnError("handleCreating_active", err)
 			metrics.WorkspacesRunning.WithLabelValues(runtime, secLevel).Dec()
@@ -183,6 +188,8 @@ func (r *WorkspaceReconciler) handleCreating(ctx context.Context, workspace *v1.
 		// to prevent re-observing the same Failed pod on next reconcile.
 		obs := observePod(existingPod)
 		class := classifyFailure(obs)
+		reason, msg := describeFailure(obs)
+		r.setFailure(workspace, reason, msg)
 		r.deletePodByName(ctx, existingPod.Name, existingPod.Namespace)
 		return r.enterRecovery(ctx, workspace, class)
 	}
@@ -195,12 +202,91 @@ func (r *WorkspaceReconciler) handleCreating(ctx context.Context, workspace *v1.
 		if obs.Unschedulable && !existingPod.CreationTimestamp.IsZero() &&
 			time.Since(existingPod.CreationTimestamp.Time) > 5*time.Minute {
 			logger.Info("Pod unschedulable for >5min; entering recovery", "pod", existingPod.Name)
+			reason, msg := describeFailure(obs)
+			r.setFailure(workspace, reason, msg)
 			r.deletePodByName(ctx, existingPod.Name, existingPod.Namespace)
 			return r.enterRecovery(ctx, workspace, FailureClassInfrastructure)
 		}
+		// Surface a stuck start (bad image, unschedulable, broken
+		// container config) while the kubelet keeps retrying, so the
+		// user sees why the workspace is not coming up. Written only on
+		// change to avoid a status update every requeue.
+		if startupProblem(obs) {
+			reason, msg := describeFailure(obs)
+			if reason != workspace.Status.FailureReason || msg != workspace.Status.Message {
+				r.setFailure(workspace, reason, msg)
+				if err := r.updateStatusRetrying(ctx, workspace, "handleCreating_startup_problem", func() {
+					workspace.Status.FailureReason = reason
+					workspace.Status.Message = msg
+				}); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+		}
+	}
+
+	// Written only on change, like the startup-problem message above.
+	if p := creationProgress(existingPod); p != workspace.Status.CreationProgress {
+		if err := r.updateStatusRetrying(ctx, workspace, "handleCreating_progress", func() {
+			workspace.Status.CreationProgress = p
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 
-	return ctrl.Result{RequeueAfter: requeueCreating}, nil
+	return ctrl.Result{RequeueAfter: creatingPollInterval(existingPod)}, nil
+}
+
+// creatingPollInterval is the fallback poll while a workspace pod is
+// starting. It stays near requeueCreating for the first minute so cold
+// starts are not slowed, then doubles per minute of pod age up to
+// requeueCreatingMax, jittered so a burst of creates does not poll in
+// lockstep. Pod and PVC watch events still trigger reconciles immediately.
+func creatingPollInterval(pod *corev1.Pod) time.Duration {
+	attempt := 0
+	if !pod.CreationTimestamp.IsZero() {
+		attempt = int(time.Since(pod.CreationTimestamp.Time) / time.Minute)
+	}
+	return common.Backoff(attempt, requeueCreating, requeueCreatingMax)
+}
+
+// creationProgress maps a workspace pod's lifecycle to the coarse
+// percentage reported in Status.CreationProgress. Each step implies the
+// ones before it, so the checks run from the furthest stage back.
+func creationProgress(pod *corev1.Pod) int32 {
+	switch {
+	case pod == nil:
+		return 0
+	case pod.Status.Phase == corev1.PodRunning && allContainersReady(pod):
+		return 100
+	case podConditionTrue(pod, corev1.PodInitialized):
+		return 75
+	case anyContainerStarted(pod.Status.InitContainerStatuses):
+		return 50
+	case podConditionTrue(pod, corev1.PodScheduled):
+		return 25
+	}
+	return 0
+}
+
+func podConditionTrue(pod *corev1.Pod, t corev1.PodConditionType) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == t {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// anyContainerStarted reports whether the kubelet has started any of the
+// given containers, which means their images have been pulled.
+func anyContainerStarted(statuses []corev1.ContainerStatus) bool {
+	for _, cs := range statuses {
+		if cs.State.Running != nil || cs.State.Terminated != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // recordStartupMetrics fires once when a workspace pod first reaches Running.
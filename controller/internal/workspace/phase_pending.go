@@ -61,9 +61,10 @@ func (r *WorkspaceReconciler) handlePending(ctx context.Context, workspace *v1.W
 			}
 			return ctrl.Result{}, err
 		}
-		workspace.Status.PVCName = pvcName
-		if err := r.Status().Update(ctx, workspace); err != nil {
-			recordStatusUpdateConflictOnError("handlePending_pvc_created", err)
+		if err := r.updateStatusRetrying(ctx, workspace, "handlePending_pvc_created", func() {
+			workspace.Status.NextRetryAt = nil
+			workspace.Status.PVCName = pvcName
+		}); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{RequeueAfter: requeueCreating}, nil
@@ -83,9 +84,11 @@ func (r *WorkspaceReconciler) handlePending(ctx context.Context, workspace *v1.W
 			return ctrl.Result{}, nil
 		}
 		if r.pendingTimedOut(workspace) {
+			r.setFailure(workspace, v1.FailureReasonPVCBindTimeout,
+				withDetail("Workspace storage did not bind in time", "PVC "+pvcName+" is "+string(existingPVC.Status.Phase)))
 			return r.enterRecovery(ctx, workspace, FailureClassInfrastructure)
 		}
-		return ctrl.Result{RequeueAfter: requeueActive}, nil
+		return ctrl.Result{RequeueAfter: common.Jitter(requeueActive)}, nil
 	}
 
 	// PVC bound — ensure password secret, then transition to Creating.
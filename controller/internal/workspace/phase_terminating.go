@@ -54,6 +54,7 @@ func (r *WorkspaceReconciler) handleTerminating(ctx context.Context, workspace *
 	r.lastDeepStatusMu.Lock()
 	delete(r.lastDeepStatus, workspace.Name)
 	r.lastDeepStatusMu.Unlock()
+	r.UsageAlerter.Forget(workspace.Name)
 	workspace.Status.PodName = ""
 	workspace.Status.PodIP = ""
 	workspace.Status.Endpoint = ""
@@ -82,12 +83,20 @@ func (r *WorkspaceReconciler) handleTerminating(ctx context.Context, workspace *
 }
 
 func (r *WorkspaceReconciler) handleDeletion(ctx context.Context, workspace *v1.Workspace) (ctrl.Result, error) {
-	if !controllerutil.ContainsFinalizer(workspace, WorkspaceFinalizer) {
-		return ctrl.Result{}, nil
+	var result ctrl.Result
+	var err error
+	if controllerutil.ContainsFinalizer(workspace, WorkspaceFinalizer) {
+		// Reuse terminating logic.
+		workspace.Status.Phase = v1.WorkspacePhaseTerminating
+		result, err = r.handleTerminating(ctx, workspace)
 	}
-	// Reuse terminating logic.
-	workspace.Status.Phase = v1.WorkspacePhaseTerminating
-	return r.handleTerminating(ctx, workspace)
+	// Stuck detection runs on every pass, not only when our cleanup
+	// fails: once our finalizer is gone a foreign one can still hold
+	// the CR.
+	if recheck := r.observeTermination(ctx, workspace, err); err == nil && recheck > 0 && result.IsZero() {
+		result.RequeueAfter = recheck
+	}
+	return result, err
 }
 
 // --- Transient recovery ---
@@ -215,6 +215,14 @@ func (r *WorkspaceReconciler) buildPod(ctx context.Context, workspace *v1.Worksp
 		)
 	}
 
+	// Per-workspace agent log level (spec.logging.level). agentd reads
+	// AGENTD_LOG_LEVEL at startup; unset keeps its info default.
+	if workspace.Spec.Logging != nil && workspace.Spec.Logging.Level != "" {
+		mainContainer.Env = append(mainContainer.Env,
+			corev1.EnvVar{Name: "AGENTD_LOG_LEVEL", Value: string(workspace.Spec.Logging.Level)},
+		)
+	}
+
 	// Workspace setup init (packages + initScript).
 	if len(workspace.Spec.Packages) > 0 || workspace.Spec.InitScript != "" {
 		initContainers = append(initContainers, buildWorkspaceSetupInit(workspace, runtimeImage))
@@ -277,7 +285,14 @@ func (r *WorkspaceReconciler) buildPod(ctx context.Context, workspace *v1.Worksp
 	//
 	// If the in-process measurement ever shows clean shutdowns
 	// approaching 5s, raise this to 10s rather than back to 30s.
+	//
+	// Spec.TerminationGracePeriodSeconds overrides the default for
+	// workloads that need longer to checkpoint; the API caps it at the
+	// workspace.maxTerminationGracePeriodSeconds setting.
 	terminationGrace := int64(5)
+	if workspace.Spec.TerminationGracePeriodSeconds != nil {
+		terminationGrace = *workspace.Spec.TerminationGracePeriodSeconds
+	}
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -322,6 +337,20 @@ func (r *WorkspaceReconciler) buildPod(ctx context.Context, workspace *v1.Worksp
 	if runtimeClassName != "" {
 		pod.Spec.RuntimeClassName = &runtimeClassName
 	}
+	if pc := r.PriorityClasses[string(workspace.Spec.Priority)]; pc != "" {
+		pod.Spec.PriorityClassName = pc
+	}
+	pullPolicy := resolveImagePullPolicy(ctx, r.Client, runtimeEnvName)
+	for i := range pod.Spec.InitContainers {
+		if pod.Spec.InitContainers[i].Image == runtimeImage {
+			pod.Spec.InitContainers[i].ImagePullPolicy = pullPolicy
+		}
+	}
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Image == runtimeImage {
+			pod.Spec.Containers[i].ImagePullPolicy = pullPolicy
+		}
+	}
 	return pod, nil
 }
 
@@ -451,9 +480,13 @@ func buildNodeSelector(workspace *v1.Workspace) map[string]string {
 	if arch == "" {
 		arch = "amd64"
 	}
-	return map[string]string{
+	sel := map[string]string{
 		"kubernetes.io/arch": arch,
 	}
+	if workspace.Spec.Region != "" {
+		sel[corev1.LabelTopologyRegion] = workspace.Spec.Region
+	}
+	return sel
 }
 
 func (r *WorkspaceReconciler) buildCredentialSetupInit(workspace *v1.Workspace, runtimeImage string, relayBaseURL string) (corev1.Container, corev1.Volume, corev1.Volume, error) {
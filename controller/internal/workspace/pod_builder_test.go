@@ -11,6 +11,7 @@ package workspace
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -297,6 +298,118 @@ func TestPodBuilder_TerminationGracePeriod_Tight(t *testing.T) {
 			"agentd exits in <1s in practice, 30s default was over-provisioned")
 }
 
+func TestPodBuilder_TerminationGracePeriod_SpecOverride(t *testing.T) {
+	ws := newWorkspaceForPodBuilder(t)
+	grace := int64(120)
+	ws.Spec.TerminationGracePeriodSeconds = &grace
+	r := reconcilerFor(t)
+
+	pod, err := r.buildPod(context.Background(), ws)
+	require.NoError(t, err)
+	require.NotNil(t, pod.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, int64(120), *pod.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestPodBuilder_LogLevel_PropagatesToAgent(t *testing.T) {
+	ws := newWorkspaceForPodBuilder(t)
+	ws.Spec.Logging = &v1.WorkspaceLoggingConfig{Level: v1.AgentLogLevelDebug}
+	r := reconcilerFor(t)
+
+	pod, err := r.buildPod(context.Background(), ws)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", getEnv(&pod.Spec.Containers[0], "AGENTD_LOG_LEVEL"))
+}
+
+func TestPodBuilder_LogLevel_UnsetOmitsEnv(t *testing.T) {
+	pod, err := reconcilerFor(t).buildPod(context.Background(), newWorkspaceForPodBuilder(t))
+	require.NoError(t, err)
+	for _, e := range pod.Spec.Containers[0].Env {
+		assert.NotEqual(t, "AGENTD_LOG_LEVEL", e.Name)
+	}
+}
+
+func TestPodBuilder_PriorityClass_Mapped(t *testing.T) {
+	ws := newWorkspaceForPodBuilder(t)
+	ws.Spec.Priority = v1.WorkspacePriorityHigh
+	r := reconcilerFor(t)
+	r.PriorityClasses = map[string]string{"high": "ws-high", "low": "ws-low"}
+
+	pod, err := r.buildPod(context.Background(), ws)
+	require.NoError(t, err)
+	assert.Equal(t, "ws-high", pod.Spec.PriorityClassName)
+}
+
+func TestPodBuilder_PriorityClass_UnmappedOrUnsetUsesDefault(t *testing.T) {
+	r := reconcilerFor(t)
+	r.PriorityClasses = map[string]string{"high": "ws-high"}
+
+	for _, p := range []v1.WorkspacePriority{"", v1.WorkspacePriorityLow} {
+		ws := newWorkspaceForPodBuilder(t)
+		ws.Spec.Priority = p
+		pod, err := r.buildPod(context.Background(), ws)
+		require.NoError(t, err)
+		assert.Emptyf(t, pod.Spec.PriorityClassName, "priority %q has no mapping", p)
+	}
+}
+
+func TestPodBuilder_ImagePullPolicy_DefaultsToKubernetes(t *testing.T) {
+	rte := &v1.RuntimeEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Name: "base"},
+		Spec:       v1.RuntimeEnvironmentSpec{Image: "llmsafespaces/runtime-base:dev", Language: "python"},
+	}
+	r := reconcilerFor(t, rte)
+	digest := "ghcr.io/lenaxia/llmsafespaces/runtimes/base@sha256:" + strings.Repeat("0", 64)
+	for _, runtime := range []string{"base", "ghcr.io/lenaxia/llmsafespaces/runtimes/base:test", digest} {
+		ws := newWorkspaceForPodBuilder(t)
+		ws.Spec.Runtime = runtime
+		pod, err := r.buildPod(context.Background(), ws)
+		require.NoError(t, err)
+		require.NotEmpty(t, pod.Spec.Containers)
+		for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			assert.Emptyf(t, c.ImagePullPolicy,
+				"container %s for %s must keep the kubelet default so side-loaded and pinned images are not re-pulled", c.Name, runtime)
+		}
+	}
+}
+
+func TestPodBuilder_ImagePullPolicy_FromRuntimeEnvironment(t *testing.T) {
+	rte := &v1.RuntimeEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Name: "base"},
+		Spec: v1.RuntimeEnvironmentSpec{
+			Image:           "ghcr.io/lenaxia/llmsafespaces/runtimes/base:latest",
+			Language:        "python",
+			ImagePullPolicy: corev1.PullIfNotPresent,
+		},
+	}
+	r := reconcilerFor(t, rte)
+	ws := newWorkspaceForPodBuilder(t)
+	ws.Spec.Runtime = "base"
+
+	pod, err := r.buildPod(context.Background(), ws)
+	require.NoError(t, err)
+	require.NotEmpty(t, pod.Spec.Containers)
+	assert.Equal(t, corev1.PullIfNotPresent, pod.Spec.Containers[0].ImagePullPolicy,
+		"RuntimeEnvironment policy overrides the tag-based default")
+}
+
+func TestPodBuilder_Region_PinsNodeSelector(t *testing.T) {
+	ws := newWorkspaceForPodBuilder(t)
+	ws.Spec.Region = "eu-west-1"
+	r := reconcilerFor(t)
+
+	pod, err := r.buildPod(context.Background(), ws)
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", pod.Spec.NodeSelector[corev1.LabelTopologyRegion])
+	assert.Equal(t, "amd64", pod.Spec.NodeSelector["kubernetes.io/arch"], "region must not replace the arch selector")
+}
+
+func TestPodBuilder_Region_UnsetLeavesSelectorAlone(t *testing.T) {
+	pod, err := reconcilerFor(t).buildPod(context.Background(), newWorkspaceForPodBuilder(t))
+	require.NoError(t, err)
+	_, ok := pod.Spec.NodeSelector[corev1.LabelTopologyRegion]
+	assert.False(t, ok)
+}
+
 // findVolume returns the named Volume from a pod spec, or nil.
 func findVolume(pod *corev1.Pod, name string) *corev1.Volume {
 	for i := range pod.Spec.Volumes {
@@ -9,8 +9,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
@@ -59,6 +61,12 @@ type WorkspaceReconciler struct {
 	// compatibility opt-out (admin-gated).
 	DefaultRuntimeClass string
 
+	// PriorityClasses maps a workspace spec.priority ("low", "normal",
+	// "high") to the Kubernetes PriorityClassName set on its pod. Set via
+	// the --priority-classes controller flag. A priority with no entry
+	// leaves PriorityClassName empty (cluster default priority).
+	PriorityClasses map[string]string
+
 	// APIServiceURL is the in-cluster URL of the API service, used by the
 	// workspace init container's bootstrap subcommand (Epic 35 US-35.4) to
 	// fetch decrypted credentials via POST /internal/v1/pod-bootstrap. Same
@@ -67,11 +75,34 @@ type WorkspaceReconciler struct {
 	// without credentials; live /v1/reload-secrets push handles delivery).
 	APIServiceURL string
 
+	// Recorder emits Kubernetes Events on the Workspace for startup and
+	// recovery failures so `kubectl describe workspace` shows the cause.
+	// Nil disables event emission (tests).
+	Recorder record.EventRecorder
+
+	// MaxConcurrentReconciles bounds how many workspaces are reconciled in
+	// parallel. Set via --max-concurrent-reconciles; 0 uses the
+	// controller-runtime default (1). Per-workspace state shared across
+	// reconciles (lastDeepStatus, OrgStatusClient's cache) is mutex-guarded,
+	// so any value is safe.
+	MaxConcurrentReconciles int
+
+	// UsageAlerter, when non-nil, posts a webhook alert when agent-reported
+	// memory or disk usage crosses its threshold. Evaluated on each
+	// enrichAgentStatus pass. Nil disables usage alerts.
+	UsageAlerter *UsageAlerter
+
 	// lastDeepStatus tracks the last time enrichAgentStatus was called per
 	// workspace. In-memory only — lost on controller restart (acceptable;
 	// the next reconcile will just call it immediately).
 	lastDeepStatus   map[string]time.Time
 	lastDeepStatusMu sync.Mutex
+
+	// stuckTerminating holds workspaces whose deletion has been failing
+	// past v1.StuckTerminatingThreshold; its size drives the
+	// WorkspacesStuckTerminating gauge. In-memory only.
+	stuckTerminating   map[string]struct{}
+	stuckTerminatingMu sync.Mutex
 }
 
 func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -81,6 +112,7 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	workspace := &v1.Workspace{}
 	if err := r.Get(ctx, req.NamespacedName, workspace); err != nil {
 		if errors.IsNotFound(err) {
+			r.clearStuckTerminating(req.Name)
 			observeReconcileDuration("Workspace", "ok", time.Since(start))
 			return ctrl.Result{}, nil
 		}
@@ -128,8 +160,15 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return result, err
 }
 
+// controllerOptions returns the work-queue options applied in
+// SetupWithManager.
+func (r *WorkspaceReconciler) controllerOptions() ctrlcontroller.Options {
+	return ctrlcontroller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}
+}
+
 func (r *WorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(r.controllerOptions()).
 		For(&v1.Workspace{}).
 		Owns(&corev1.Pod{}).
 		Owns(&corev1.Secret{}).
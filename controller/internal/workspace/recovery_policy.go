@@ -4,10 +4,12 @@ import (
 	"context"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/lenaxia/llmsafespaces/controller/internal/common"
 	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
 )
 
@@ -92,6 +94,18 @@ func maybeResetConsecutiveFailures(ws *v1.Workspace) {
 
 const stabilityResetWindow = 2 * time.Minute
 
+// setFailure records a typed failure reason and message on the workspace
+// status (persisted by the caller's status update) and emits a Warning
+// event when the reason or message changed since the last report.
+func (r *WorkspaceReconciler) setFailure(ws *v1.Workspace, reason v1.FailureReason, message string) {
+	changed := ws.Status.FailureReason != reason || ws.Status.Message != message
+	ws.Status.FailureReason = reason
+	ws.Status.Message = message
+	if changed && r.Recorder != nil && reason != v1.FailureReasonNone {
+		r.Recorder.Event(ws, corev1.EventTypeWarning, string(reason), message)
+	}
+}
+
 func (r *WorkspaceReconciler) enterRecovery(ctx context.Context, ws *v1.Workspace, class FailureClass) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
@@ -117,7 +131,9 @@ func (r *WorkspaceReconciler) enterRecovery(ctx context.Context, ws *v1.Workspac
 			"failures", ws.Status.ConsecutiveFailures, "class", class)
 	}
 
-	backoff := calculateBackoff(ws.Status.ConsecutiveFailures, policy)
+	// Jittered so a burst of failures (node loss, registry outage) does
+	// not retry every affected workspace in the same instant.
+	backoff := common.Jitter(calculateBackoff(ws.Status.ConsecutiveFailures, policy))
 	if backoff > 0 {
 		nextRetry := metav1.NewTime(now.Add(backoff))
 		ws.Status.NextRetryAt = &nextRetry
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	ctrMetrics "github.com/lenaxia/llmsafespaces/controller/internal/metrics"
@@ -196,3 +197,116 @@ func TestHandleCreating_UnschedulablePod_5Min_EntersRecovery(t *testing.T) {
 	assert.Equal(t, string(FailureClassInfrastructure), updated.Status.LastFailureClass)
 	assert.NotNil(t, updated.Status.NextRetryAt)
 }
+
+func TestHandleCreating_FailedPod_RecordsFailureReasonAndEvent(t *testing.T) {
+	scheme := testScheme(t)
+	ws := makeWorkspace("ws-oom", "default", v1.WorkspacePhaseCreating)
+	ws.UID = "ws-oom-uid"
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: podName("ws-oom", string(ws.UID)), Namespace: "default"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			}},
+		},
+	}
+
+	fc := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(ws, pod).
+		WithStatusSubresource(&v1.Workspace{}).
+		Build()
+	rec := record.NewFakeRecorder(4)
+	r := &WorkspaceReconciler{Client: fc, Scheme: scheme, Recorder: rec}
+
+	_, err := r.handleCreating(context.Background(), ws)
+	require.NoError(t, err)
+
+	updated := &v1.Workspace{}
+	require.NoError(t, fc.Get(context.Background(), types.NamespacedName{Name: "ws-oom", Namespace: "default"}, updated))
+	assert.Equal(t, v1.FailureReasonOOMKilled, updated.Status.FailureReason)
+	assert.Contains(t, updated.Status.Message, "out of memory")
+	assert.Equal(t, string(FailureClassResource), updated.Status.LastFailureClass)
+
+	select {
+	case e := <-rec.Events:
+		assert.Contains(t, e, "Warning OOMKilled")
+	default:
+		t.Fatal("expected a Warning event for the failure")
+	}
+}
+
+func TestHandleCreating_ImagePullBackOff_SurfacedWithoutRecovery(t *testing.T) {
+	scheme := testScheme(t)
+	ws := makeWorkspace("ws-pull", "default", v1.WorkspacePhaseCreating)
+	ws.UID = "ws-pull-uid"
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              podName("ws-pull", string(ws.UID)),
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "ImagePullBackOff",
+					Message: `Back-off pulling image "ghcr.io/test/python:missing"`,
+				}},
+			}},
+		},
+	}
+
+	fc := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(ws, pod).
+		WithStatusSubresource(&v1.Workspace{}).
+		Build()
+	rec := record.NewFakeRecorder(4)
+	r := &WorkspaceReconciler{Client: fc, Scheme: scheme, Recorder: rec}
+
+	_, err := r.handleCreating(context.Background(), ws)
+	require.NoError(t, err)
+
+	updated := &v1.Workspace{}
+	require.NoError(t, fc.Get(context.Background(), types.NamespacedName{Name: "ws-pull", Namespace: "default"}, updated))
+	assert.Equal(t, v1.WorkspacePhaseCreating, updated.Status.Phase)
+	assert.Equal(t, v1.FailureReasonImagePullFailed, updated.Status.FailureReason)
+	assert.Contains(t, updated.Status.Message, "ghcr.io/test/python:missing")
+	assert.Equal(t, int32(0), updated.Status.ConsecutiveFailures, "a stuck pull is reported, not recovered")
+	assert.Len(t, rec.Events, 1)
+
+	// A second reconcile with the same observation must not re-emit.
+	_, err = r.handleCreating(context.Background(), updated)
+	require.NoError(t, err)
+	assert.Len(t, rec.Events, 1)
+}
+
+func TestHandleCreating_Active_ClearsFailureReason(t *testing.T) {
+	scheme := testScheme(t)
+	ws := makeWorkspace("ws-ok", "default", v1.WorkspacePhaseCreating)
+	ws.UID = "ws-ok-uid"
+	ws.Status.FailureReason = v1.FailureReasonImagePullFailed
+	ws.Status.Message = "Runtime image could not be pulled"
+
+	pod := makeRunningPod(podName("ws-ok", string(ws.UID)), "default", "10.0.0.9")
+
+	fc := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(ws, pod).
+		WithStatusSubresource(&v1.Workspace{}).
+		Build()
+	r := &WorkspaceReconciler{Client: fc, Scheme: scheme}
+
+	_, err := r.handleCreating(context.Background(), ws)
+	require.NoError(t, err)
+
+	updated := &v1.Workspace{}
+	require.NoError(t, fc.Get(context.Background(), types.NamespacedName{Name: "ws-ok", Namespace: "default"}, updated))
+	assert.Equal(t, v1.WorkspacePhaseActive, updated.Status.Phase)
+	assert.Equal(t, v1.FailureReasonNone, updated.Status.FailureReason)
+	assert.Empty(t, updated.Status.Message)
+}
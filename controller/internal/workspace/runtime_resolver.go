@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -84,3 +85,21 @@ func resolveRuntimeImage(
 	return "", "", fmt.Errorf(
 		"no RuntimeEnvironment found matching workspace.spec.runtime=%q", runtime)
 }
+
+// resolveImagePullPolicy returns the matched RuntimeEnvironment's
+// spec.imagePullPolicy, or "" to leave the Kubernetes default in place:
+// Always for :latest or untagged images, IfNotPresent for pinned tags and
+// digests. Forcing Always would break side-loaded images (kind load) and
+// re-pull pinned tags on every start. envName is empty for explicit image
+// references; a lookup failure falls back to the default rather than
+// failing the pod build.
+func resolveImagePullPolicy(ctx context.Context, c client.Reader, envName string) corev1.PullPolicy {
+	if envName == "" {
+		return ""
+	}
+	env := &v1.RuntimeEnvironment{}
+	if err := c.Get(ctx, types.NamespacedName{Name: envName}, env); err != nil {
+		return ""
+	}
+	return env.Spec.ImagePullPolicy
+}
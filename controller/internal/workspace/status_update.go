@@ -0,0 +1,41 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lenaxia/llmsafespaces/controller/internal/common"
+	"github.com/lenaxia/llmsafespaces/controller/internal/metrics"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// errPhaseChangedDuringRetry aborts updateStatusRetrying when the re-fetched
+// workspace is no longer in the phase the caller computed its change for.
+var errPhaseChangedDuringRetry = errors.New("workspace phase changed concurrently")
+
+// updateStatusRetrying writes a status-only change via
+// common.RetryOnConflict instead of failing the whole reconcile on the
+// first 409. mutate must only assign values the caller already computed.
+// Each conflict is counted against site. If a concurrent writer moved the
+// workspace to another phase, the change no longer applies and the reconcile
+// starts over from the fresh object.
+func (r *WorkspaceReconciler) updateStatusRetrying(ctx context.Context, ws *v1.Workspace, site string, mutate func()) error {
+	phase := ws.Status.Phase
+	attempt := 0
+	err := common.RetryOnConflict(ctx, r.Client, ws, func() error {
+		if attempt > 0 {
+			recordStatusUpdateConflictInto(metrics.WorkspaceStatusUpdateConflictsTotal, site)
+		}
+		attempt++
+		if ws.Status.Phase != phase {
+			return errPhaseChangedDuringRetry
+		}
+		mutate()
+		return nil
+	})
+	recordStatusUpdateConflictOnError(site, err)
+	return err
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+func TestUpdateStatusRetrying_AbortsWhenPhaseChanged(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	stored := &v1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-1", Namespace: "default"},
+		Status:     v1.WorkspaceStatus{Phase: v1.WorkspacePhaseActive},
+	}
+	conflicted := false
+	fc := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(stored).WithStatusSubresource(stored).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, sub string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				if !conflicted {
+					conflicted = true
+					return apierrors.NewConflict(v1.Resource("workspaces"), obj.GetName(), nil)
+				}
+				return c.Status().Update(ctx, obj, opts...)
+			},
+		}).Build()
+	r := &WorkspaceReconciler{Client: fc, Scheme: scheme}
+
+	// The reconcile computed a Creating-phase change from a stale copy;
+	// the workspace has since gone Active.
+	local := stored.DeepCopy()
+	local.Status.Phase = v1.WorkspacePhaseCreating
+	err := r.updateStatusRetrying(context.Background(), local, "test", func() {
+		local.Status.CreationProgress = 40
+	})
+	assert.ErrorIs(t, err, errPhaseChangedDuringRetry)
+
+	got := &v1.Workspace{}
+	require.NoError(t, fc.Get(context.Background(), client.ObjectKeyFromObject(stored), got))
+	assert.Equal(t, int32(0), got.Status.CreationProgress, "a stale change must not be applied")
+}
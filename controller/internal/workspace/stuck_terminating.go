@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/lenaxia/llmsafespaces/controller/internal/metrics"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// eventReasonTerminationStuck is the Warning event emitted once when a
+// workspace's deletion has been pending past v1.StuckTerminatingThreshold.
+const eventReasonTerminationStuck = "TerminationStuck"
+
+// observeTermination judges a deleting workspace by the age of its
+// DeletionTimestamp, whatever is holding it: our own cleanup failing
+// (cause != nil), a foreign finalizer, or a pod that never dies. Once the
+// deletion is older than v1.StuckTerminatingThreshold the workspace is
+// counted in WorkspacesStuckTerminating and a single Warning event points
+// operators at the admin force-clean endpoint.
+//
+// It returns how long until a still-young deletion crosses the threshold,
+// so the caller can requeue: nothing else may trigger another reconcile
+// while a foreign finalizer holds the CR.
+func (r *WorkspaceReconciler) observeTermination(ctx context.Context, ws *v1.Workspace, cause error) time.Duration {
+	if ws.DeletionTimestamp.IsZero() || (cause == nil && len(ws.Finalizers) == 0) {
+		r.clearStuckTerminating(ws.Name)
+		return 0
+	}
+	pending := time.Since(ws.DeletionTimestamp.Time)
+	if pending < v1.StuckTerminatingThreshold {
+		return v1.StuckTerminatingThreshold - pending
+	}
+
+	r.stuckTerminatingMu.Lock()
+	if r.stuckTerminating == nil {
+		r.stuckTerminating = make(map[string]struct{})
+	}
+	_, seen := r.stuckTerminating[ws.Name]
+	r.stuckTerminating[ws.Name] = struct{}{}
+	metrics.WorkspacesStuckTerminating.Set(float64(len(r.stuckTerminating)))
+	r.stuckTerminatingMu.Unlock()
+
+	if seen {
+		return 0
+	}
+	reason := fmt.Sprintf("finalizers %v remain", ws.Finalizers)
+	if cause != nil {
+		reason = cause.Error()
+	}
+	log.FromContext(ctx).Info("workspace stuck terminating",
+		"workspace", ws.Name, "pending", pending.Round(time.Second).String(), "reason", reason)
+	if r.Recorder != nil {
+		r.Recorder.Event(ws, corev1.EventTypeWarning, eventReasonTerminationStuck,
+			fmt.Sprintf("deletion has been pending for %s: %s; an admin can POST /api/v1/admin/workspaces/%s/force-clean",
+				pending.Round(time.Second), reason, ws.Name))
+	}
+	return 0
+}
+
+// clearStuckTerminating drops a workspace from the stuck set once its
+// deletion completes or the CR is gone (e.g. after a force-clean).
+func (r *WorkspaceReconciler) clearStuckTerminating(name string) {
+	r.stuckTerminatingMu.Lock()
+	defer r.stuckTerminatingMu.Unlock()
+	if _, ok := r.stuckTerminating[name]; !ok {
+		return
+	}
+	delete(r.stuckTerminating, name)
+	metrics.WorkspacesStuckTerminating.Set(float64(len(r.stuckTerminating)))
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	ctrMetrics "github.com/lenaxia/llmsafespaces/controller/internal/metrics"
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+func deletingWorkspace(name string, age time.Duration) *v1.Workspace {
+	ts := metav1.NewTime(time.Now().Add(-age))
+	return &v1.Workspace{ObjectMeta: metav1.ObjectMeta{
+		Name: name, Namespace: "default", DeletionTimestamp: &ts,
+		Finalizers: []string{WorkspaceFinalizer},
+	}}
+}
+
+func TestObserveTermination_TracksOnlyPastThreshold(t *testing.T) {
+	ctrMetrics.WorkspacesStuckTerminating.Set(0)
+	rec := record.NewFakeRecorder(4)
+	r := &WorkspaceReconciler{Recorder: rec}
+	ctx := context.Background()
+	cause := errors.New("pvc delete forbidden")
+
+	recheck := r.observeTermination(ctx, deletingWorkspace("ws-young", time.Minute), cause)
+	assert.InDelta(t, float64(v1.StuckTerminatingThreshold-time.Minute), float64(recheck), float64(time.Second))
+	assert.Equal(t, 0.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+	assert.Empty(t, rec.Events, "a young deletion is not stuck")
+
+	stuck := deletingWorkspace("ws-stuck", v1.StuckTerminatingThreshold+time.Minute)
+	r.observeTermination(ctx, stuck, cause)
+	r.observeTermination(ctx, stuck, cause)
+	assert.Equal(t, 1.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+
+	select {
+	case e := <-rec.Events:
+		assert.Contains(t, e, "Warning "+eventReasonTerminationStuck)
+		assert.Contains(t, e, "force-clean")
+	default:
+		t.Fatal("expected a TerminationStuck event")
+	}
+	assert.Empty(t, rec.Events, "repeat failures must not re-emit the event")
+
+	r.clearStuckTerminating("ws-stuck")
+	assert.Equal(t, 0.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+}
+
+func TestHandleDeletion_ClearsStuckWhenFinalizerGone(t *testing.T) {
+	ctrMetrics.WorkspacesStuckTerminating.Set(0)
+	r := &WorkspaceReconciler{}
+	ws := deletingWorkspace("ws-cleaned", v1.StuckTerminatingThreshold+time.Minute)
+	r.observeTermination(context.Background(), ws, errors.New("boom"))
+	assert.Equal(t, 1.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+
+	// A force-clean strips the finalizer; the next reconcile must drop
+	// the workspace from the stuck set.
+	ws.Finalizers = nil
+	_, err := r.handleDeletion(context.Background(), ws)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+}
+
+func TestHandleDeletion_ForeignFinalizerCountsAsStuck(t *testing.T) {
+	ctrMetrics.WorkspacesStuckTerminating.Set(0)
+	rec := record.NewFakeRecorder(4)
+	r := &WorkspaceReconciler{Recorder: rec}
+	ctx := context.Background()
+
+	// Our finalizer is already gone; another controller's keeps the CR.
+	young := deletingWorkspace("ws-held", time.Minute)
+	young.Finalizers = []string{"example.com/backup"}
+	result, err := r.handleDeletion(ctx, young)
+	assert.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter, "a young deletion must be rechecked at the threshold")
+	assert.Equal(t, 0.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+
+	old := deletingWorkspace("ws-held", v1.StuckTerminatingThreshold+time.Minute)
+	old.Finalizers = []string{"example.com/backup"}
+	result, err = r.handleDeletion(ctx, old)
+	assert.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, 1.0, readPlainGaugeValue(t, ctrMetrics.WorkspacesStuckTerminating))
+
+	select {
+	case e := <-rec.Events:
+		assert.Contains(t, e, "Warning "+eventReasonTerminationStuck)
+		assert.Contains(t, e, "example.com/backup")
+	default:
+		t.Fatal("expected a TerminationStuck event")
+	}
+
+	r.clearStuckTerminating("ws-held")
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// Usage alert resources.
+const (
+	UsageResourceMemory = "memory"
+	UsageResourceDisk   = "disk"
+)
+
+// DefaultUsageAlertCooldown is how long a workspace/resource pair stays
+// silent after an alert while usage remains above the threshold.
+const DefaultUsageAlertCooldown = 30 * time.Minute
+
+// UsageAlert is the JSON body posted to the alert webhook.
+type UsageAlert struct {
+	Workspace  string    `json:"workspace"`
+	Namespace  string    `json:"namespace"`
+	UserID     string    `json:"userId,omitempty"`
+	Resource   string    `json:"resource"`
+	UsedBytes  int64     `json:"usedBytes"`
+	TotalBytes int64     `json:"totalBytes"`
+	Ratio      float64   `json:"ratio"`
+	Threshold  float64   `json:"threshold"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// UsageAlerter posts a webhook alert when a workspace's agent-reported
+// memory or disk usage crosses a threshold fraction of its total.
+//
+// Dedup: the first crossing alerts immediately. While usage stays above
+// the threshold, repeats are suppressed for Cooldown. Dropping below the
+// threshold re-arms the pair so the next crossing alerts at once.
+//
+// Delivery is fire-and-forget on a goroutine with a 5s timeout so a slow
+// receiver never stalls reconciles; failures are logged, not retried
+// (the next evaluation after the cooldown alerts again).
+type UsageAlerter struct {
+	webhookURL      string
+	memoryThreshold float64
+	diskThreshold   float64
+	cooldown        time.Duration
+	httpClient      *http.Client
+	logger          OrgStatusLogger
+	now             func() time.Time
+
+	mu       sync.Mutex
+	lastSent map[string]time.Time // "<workspace>/<resource>" -> last alert
+}
+
+// NewUsageAlerter constructs an alerter. A threshold <= 0 disables alerts
+// for that resource; cooldown <= 0 uses DefaultUsageAlertCooldown. logger
+// may be nil.
+func NewUsageAlerter(webhookURL string, memoryThreshold, diskThreshold float64, cooldown time.Duration, logger OrgStatusLogger) *UsageAlerter {
+	if cooldown <= 0 {
+		cooldown = DefaultUsageAlertCooldown
+	}
+	return &UsageAlerter{
+		webhookURL:      webhookURL,
+		memoryThreshold: memoryThreshold,
+		diskThreshold:   diskThreshold,
+		cooldown:        cooldown,
+		httpClient:      &http.Client{Timeout: 5 * time.Second},
+		logger:          logger,
+		now:             time.Now,
+		lastSent:        make(map[string]time.Time),
+	}
+}
+
+// Evaluate checks ws's reported usage and sends any due alerts. It returns
+// the alerts it dispatched (for tests and logging). Safe for concurrent use.
+func (a *UsageAlerter) Evaluate(ws *v1.Workspace) []UsageAlert {
+	if a == nil || a.webhookURL == "" {
+		return nil
+	}
+	var fired []UsageAlert
+	if alert, ok := a.check(ws, UsageResourceMemory, ws.Status.MemoryUsedBytes, ws.Status.MemoryTotalBytes, a.memoryThreshold); ok {
+		fired = append(fired, alert)
+	}
+	if alert, ok := a.check(ws, UsageResourceDisk, ws.Status.DiskUsedBytes, ws.Status.DiskTotalBytes, a.diskThreshold); ok {
+		fired = append(fired, alert)
+	}
+	for _, alert := range fired {
+		go a.send(alert)
+	}
+	return fired
+}
+
+// Forget drops dedup state for a workspace (called on termination).
+func (a *UsageAlerter) Forget(workspace string) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.lastSent, workspace+"/"+UsageResourceMemory)
+	delete(a.lastSent, workspace+"/"+UsageResourceDisk)
+}
+
+func (a *UsageAlerter) check(ws *v1.Workspace, resource string, used, total int64, threshold float64) (UsageAlert, bool) {
+	if threshold <= 0 || total <= 0 {
+		return UsageAlert{}, false
+	}
+	key := ws.Name + "/" + resource
+	ratio := float64(used) / float64(total)
+	now := a.now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if ratio < threshold {
+		delete(a.lastSent, key)
+		return UsageAlert{}, false
+	}
+	if last, ok := a.lastSent[key]; ok && now.Sub(last) < a.cooldown {
+		return UsageAlert{}, false
+	}
+	a.lastSent[key] = now
+	return UsageAlert{
+		Workspace:  ws.Name,
+		Namespace:  ws.Namespace,
+		UserID:     ws.Labels["user-id"],
+		Resource:   resource,
+		UsedBytes:  used,
+		TotalBytes: total,
+		Ratio:      ratio,
+		Threshold:  threshold,
+		Timestamp:  now,
+	}, true
+}
+
+func (a *UsageAlerter) send(alert UsageAlert) {
+	if err := a.post(context.Background(), alert); err != nil && a.logger != nil {
+		a.logger.Error(err, "usage alert delivery failed",
+			"workspace", alert.Workspace, "resource", alert.Resource)
+	}
+}
+
+func (a *UsageAlerter) post(ctx context.Context, alert UsageAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("marshal usage alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build usage alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("post usage alert: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("usage alert webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package workspace
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+func usageWorkspace(memUsed, memTotal int64) *v1.Workspace {
+	ws := makeWorkspace("ws-usage", "default", v1.WorkspacePhaseActive)
+	ws.Status.MemoryUsedBytes = memUsed
+	ws.Status.MemoryTotalBytes = memTotal
+	return ws
+}
+
+func TestUsageAlerter_ThresholdCrossingPostsAlert(t *testing.T) {
+	got := make(chan UsageAlert, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a UsageAlert
+		_ = json.NewDecoder(r.Body).Decode(&a)
+		got <- a
+	}))
+	defer srv.Close()
+	a := NewUsageAlerter(srv.URL, 0.9, 0, time.Hour, nil)
+
+	fired := a.Evaluate(usageWorkspace(95, 100))
+	require.Len(t, fired, 1)
+
+	select {
+	case alert := <-got:
+		assert.Equal(t, "ws-usage", alert.Workspace)
+		assert.Equal(t, UsageResourceMemory, alert.Resource)
+		assert.InDelta(t, 0.95, alert.Ratio, 1e-9)
+		assert.Equal(t, 0.9, alert.Threshold)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook never received the alert")
+	}
+}
+
+func TestUsageAlerter_BelowThresholdDoesNotAlert(t *testing.T) {
+	a := NewUsageAlerter("http://127.0.0.1:0", 0.9, 0.9, time.Hour, nil)
+	assert.Empty(t, a.Evaluate(usageWorkspace(50, 100)))
+}
+
+func TestUsageAlerter_CooldownSuppressesRepeats(t *testing.T) {
+	a := NewUsageAlerter("http://127.0.0.1:0", 0.9, 0, time.Hour, nil)
+	now := time.Now()
+	a.now = func() time.Time { return now }
+	ws := usageWorkspace(95, 100)
+
+	require.Len(t, a.Evaluate(ws), 1)
+	now = now.Add(10 * time.Minute)
+	assert.Empty(t, a.Evaluate(ws), "repeat within cooldown must be suppressed")
+	now = now.Add(time.Hour)
+	assert.Len(t, a.Evaluate(ws), 1, "alert repeats once the cooldown has elapsed")
+}
+
+func TestUsageAlerter_DroppingBelowRearms(t *testing.T) {
+	a := NewUsageAlerter("http://127.0.0.1:0", 0.9, 0, time.Hour, nil)
+	require.Len(t, a.Evaluate(usageWorkspace(95, 100)), 1)
+	assert.Empty(t, a.Evaluate(usageWorkspace(50, 100)))
+	assert.Len(t, a.Evaluate(usageWorkspace(96, 100)), 1, "a fresh crossing alerts without waiting for the cooldown")
+}
+
+func TestUsageAlerter_NilIsNoop(t *testing.T) {
+	var a *UsageAlerter
+	assert.Empty(t, a.Evaluate(usageWorkspace(100, 100)))
+	a.Forget("ws-usage")
+}
@@ -115,6 +115,28 @@ func main() {
 			"Set to 'gvisor' for production multi-tenant isolation. "+
 			"Empty means runc (default K8s runtime). "+
 			"Individual workspaces can override via spec.runtimeClass.")
+	var priorityClasses string
+	flag.StringVar(&priorityClasses, "priority-classes", "",
+		"Comma-separated <priority>=<PriorityClassName> pairs mapping workspace spec.priority "+
+			"(low, normal, high) to the pod's PriorityClassName, e.g. 'low=ws-low,high=ws-high'. "+
+			"Unmapped priorities use the cluster default.")
+	var maxConcurrentReconciles int
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"Maximum number of Workspace (and InferenceRelay) objects reconciled in parallel. "+
+			"Raise on large clusters where reconcile throughput lags.")
+	var usageAlertWebhookURL string
+	flag.StringVar(&usageAlertWebhookURL, "usage-alert-webhook-url", "",
+		"URL that receives a JSON POST when a workspace's memory or disk usage crosses its alert threshold. "+
+			"Empty disables usage alerts.")
+	var usageAlertMemoryThreshold float64
+	flag.Float64Var(&usageAlertMemoryThreshold, "usage-alert-memory-threshold", 0.9,
+		"Fraction of the workspace memory limit that triggers a usage alert. 0 disables memory alerts.")
+	var usageAlertDiskThreshold float64
+	flag.Float64Var(&usageAlertDiskThreshold, "usage-alert-disk-threshold", 0.9,
+		"Fraction of the workspace volume that triggers a usage alert. 0 disables disk alerts.")
+	var usageAlertCooldown time.Duration
+	flag.DurationVar(&usageAlertCooldown, "usage-alert-cooldown", 30*time.Minute,
+		"Minimum time between repeat alerts for the same workspace and resource while usage stays high.")
 	var maxWorkspacesPerTenant int
 	flag.IntVar(&maxWorkspacesPerTenant, "max-workspaces-per-tenant", 0,
 		"Maximum concurrent workspace pods per tenant (Epic 51 S51.2). "+
@@ -251,7 +273,17 @@ func main() {
 	}
 
 	// Set up controllers
-	if err := controller.SetupControllers(mgr, inferenceRelayURL, inferenceRelaySecret, apiServiceURL, apiInternalToken, defaultRuntimeClass); err != nil {
+	priorityClassMap, err := parsePriorityClasses(priorityClasses)
+	if err != nil {
+		setupLog.Error(err, "invalid --priority-classes")
+		os.Exit(1)
+	}
+	if err := controller.SetupControllers(mgr, inferenceRelayURL, inferenceRelaySecret, apiServiceURL, apiInternalToken, defaultRuntimeClass, priorityClassMap, maxConcurrentReconciles, controller.UsageAlertConfig{
+		WebhookURL:      usageAlertWebhookURL,
+		MemoryThreshold: usageAlertMemoryThreshold,
+		DiskThreshold:   usageAlertDiskThreshold,
+		Cooldown:        usageAlertCooldown,
+	}); err != nil {
 		setupLog.Error(err, "unable to set up controllers")
 		os.Exit(1)
 	}
@@ -265,7 +297,7 @@ func main() {
 		URLs:        splitNonEmpty(relayArtifactURL, ","),
 		SHA256Arm64: relayArtifactSHA256Arm64,
 		SHA256Amd64: relayArtifactSHA256Amd64,
-	}); err != nil {
+	}, maxConcurrentReconciles); err != nil {
 		setupLog.Error(err, "unable to set up InferenceRelay controller")
 		os.Exit(1)
 	}
@@ -315,6 +347,11 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	readyNS := readinessNamespace(parseWatchNamespaces(watchNamespaces))
+	if err := mgr.AddReadyzCheck("crd-reachable", crdReachableCheck(mgr.GetAPIReader(), readyNS, crdReachableTimeout)); err != nil {
+		setupLog.Error(err, "unable to set up CRD readiness check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
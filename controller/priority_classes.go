@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// parsePriorityClasses converts the --priority-classes flag value into the
+// reconciler's spec.priority → PriorityClassName map.
+//
+// Behavior:
+//   - empty string                       → nil (no pod gets a PriorityClassName)
+//   - "high=ws-high"                     → {"high": "ws-high"}
+//   - "low=ws-low, normal=ws-normal"     → {"low": "ws-low", "normal": "ws-normal"}
+//
+// Whitespace is trimmed and empty entries are ignored. An entry without
+// "=", an empty class name, or a key that is not a valid workspace
+// priority is an error so a typo fails the controller at boot instead of
+// silently scheduling every workspace at the default priority.
+func parsePriorityClasses(s string) (map[string]string, error) {
+	entries := splitNonEmpty(s, ",")
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(entries))
+	for _, e := range entries {
+		key, class, ok := strings.Cut(e, "=")
+		key, class = strings.TrimSpace(key), strings.TrimSpace(class)
+		if !ok || key == "" || class == "" {
+			return nil, fmt.Errorf("invalid --priority-classes entry %q: want <priority>=<PriorityClassName>", e)
+		}
+		if !v1.WorkspacePriority(key).IsValid() {
+			return nil, fmt.Errorf("invalid --priority-classes priority %q: must be one of low, normal, high", key)
+		}
+		out[key] = class
+	}
+	return out, nil
+}
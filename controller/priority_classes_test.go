@@ -0,0 +1,30 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriorityClasses_EmptyMeansNone(t *testing.T) {
+	got, err := parsePriorityClasses("  ")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestParsePriorityClasses_ParsesEntries(t *testing.T) {
+	got, err := parsePriorityClasses(" low=ws-low , high=ws-high,,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"low": "ws-low", "high": "ws-high"}, got)
+}
+
+func TestParsePriorityClasses_RejectsMalformed(t *testing.T) {
+	for _, in := range []string{"high", "high=", "=ws-high", "urgent=ws-urgent"} {
+		_, err := parsePriorityClasses(in)
+		assert.Errorf(t, err, "expected error for %q", in)
+	}
+}
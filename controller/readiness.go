@@ -0,0 +1,58 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// crdReachableTimeout bounds the readiness List so a slow API server fails
+// the probe instead of hanging it past the kubelet's probe timeout.
+const crdReachableTimeout = 3 * time.Second
+
+// crdReachableCheck returns a readiness checker that lists at most one
+// Workspace straight from the API server (reader must be uncached, e.g.
+// mgr.GetAPIReader()). It fails while the API server is unreachable or the
+// Workspace CRD is not installed, so the controller is not marked ready
+// before it can reconcile. namespace scopes the List to one the controller
+// is allowed to read; "" lists across all namespaces.
+func crdReachableCheck(reader client.Reader, namespace string, timeout time.Duration) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		opts := []client.ListOption{client.Limit(1)}
+		if namespace != "" {
+			opts = append(opts, client.InNamespace(namespace))
+		}
+		if err := reader.List(ctx, &v1.WorkspaceList{}, opts...); err != nil {
+			return fmt.Errorf("workspace CRD not reachable: %w", err)
+		}
+		return nil
+	}
+}
+
+// readinessNamespace picks the namespace the readiness List runs in: the
+// first watched namespace in sorted order, or "" when watching all of them.
+// In namespace-scoped RBAC mode a cluster-wide List would be forbidden.
+func readinessNamespace(nsMap map[string]cache.Config) string {
+	if len(nsMap) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(nsMap))
+	for ns := range nsMap {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	return names[0]
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/lenaxia/llmsafespaces/pkg/apis/llmsafespaces/v1"
+)
+
+// blockingReader never answers until its context is done, like an API
+// server that accepted the connection but stopped responding.
+type blockingReader struct{ client.Reader }
+
+func (blockingReader) List(ctx context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestCRDReachableCheck_ReadyWhenCRDListable(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1.AddToScheme(s)
+	reader := fake.NewClientBuilder().WithScheme(s).Build()
+
+	check := crdReachableCheck(reader, "llmsafespaces", time.Second)
+	assert.NoError(t, check(httptest.NewRequest("GET", "/readyz", nil)))
+}
+
+func TestCRDReachableCheck_NotReadyWhenCRDMissing(t *testing.T) {
+	// A scheme without the llmsafespaces types stands in for an API server
+	// on which the Workspace CRD is not installed.
+	reader := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+	check := crdReachableCheck(reader, "", time.Second)
+	assert.ErrorContains(t, check(httptest.NewRequest("GET", "/readyz", nil)), "workspace CRD not reachable")
+}
+
+func TestCRDReachableCheck_TimesOut(t *testing.T) {
+	check := crdReachableCheck(blockingReader{}, "", 20*time.Millisecond)
+
+	start := time.Now()
+	err := check(httptest.NewRequest("GET", "/readyz", nil))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second, "a slow API server must not block the probe")
+}
+
+func TestReadinessNamespace(t *testing.T) {
+	assert.Equal(t, "", readinessNamespace(nil))
+	assert.Equal(t, "a-ns", readinessNamespace(map[string]cache.Config{"b-ns": {}, "a-ns": {}}))
+}
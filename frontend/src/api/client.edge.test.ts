@@ -47,7 +47,13 @@ describe("API client — auth error handling", () => {
       ok: false,
       status: 429,
       statusText: "Too Many Requests",
-      json: () => Promise.resolve({ error: "rate limited", retryAfter: 10 }),
+      json: () =>
+        Promise.resolve({
+          error: "rate limited",
+          code: "rate_limited",
+          message: "rate limited",
+          details: { retryAfter: 10 },
+        }),
     });
 
     try {
@@ -55,7 +61,7 @@ describe("API client — auth error handling", () => {
       expect.fail("should have thrown");
     } catch (e) {
       expect((e as ApiClientError).status).toBe(429);
-      expect((e as ApiClientError).body).toHaveProperty("retryAfter", 10);
+      expect((e as ApiClientError).body.details?.retryAfter).toBe(10);
     }
   });
 
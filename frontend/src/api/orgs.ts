@@ -158,7 +158,7 @@ export const orgsApi = {
    * can log in. The invitation itself stays pending; the user must
    * still click the invitation link to accept and join the org.
    *
-   * On 422 with body `{"error":"no_account_for_email"}`, the invitee
+   * On 422 with body `{"code":"no_account_for_email"}`, the invitee
    * has no users row yet and must sign up before this action can be
    * used. The frontend renders a clear message in that case.
    */
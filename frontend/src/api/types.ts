@@ -178,20 +178,16 @@ export interface CreateApiKeyResponse {
 export interface ApiError {
   error: string;
   code?: string;
+  message?: string;
   /**
-   * Per-field validation details, keyed by JSON field name (e.g. "slug",
-   * "ownerEmail"). Present on 400 responses generated by Gin binding
-   * failures via bindingErrorResponse() in the backend. Absent for other
-   * 4xx/5xx responses.
-   */
-  details?: Record<string, string>;
-  /**
-   * Seconds to wait before retrying. Sent by the proxy on 429 (active-
-   * session cap / rate limit) and 503 (workspace restarting — in-place
-   * opencode restart for credential reload, OOM, crash, relay injection).
-   * Mirrors the HTTP `Retry-After` header value.
+   * Structured context for the failure. On validation_error these are
+   * per-field messages keyed by JSON field name (e.g. "slug",
+   * "ownerEmail"). The proxy's 429 (active-session cap / rate limit) and
+   * 503 (workspace restarting — in-place opencode restart for credential
+   * reload, OOM, crash, relay injection) carry `retryAfter` in seconds,
+   * mirroring the HTTP `Retry-After` header value.
    */
-  retryAfter?: number;
+  details?: Record<string, unknown>;
 }
 
 // --- Workspace SSE event types ---
@@ -100,7 +100,8 @@ describe("ChatHistoryErrorBanner", () => {
     // (via ApiError type), no opencode fields.
     const err = new ApiClientError(503, {
       error: "workspace connection failed",
-      retryAfter: 5,
+      code: "service_unavailable",
+      details: { retryAfter: 5 },
     });
     render(<ChatHistoryErrorBanner error={err} onRetry={vi.fn()} />);
     fireEvent.click(screen.getByText("Details"));
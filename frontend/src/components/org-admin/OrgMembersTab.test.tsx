@@ -247,7 +247,10 @@ describe("OrgMembersTab", () => {
     mockListMembers.mockResolvedValue([VERIFIED_ADMIN]);
     mockListInvitations.mockResolvedValue([PENDING_INVITATION]);
     mockVerifyInvitee.mockRejectedValue(
-      new ApiClientError(422, { error: "no_account_for_email" }),
+      new ApiClientError(422, {
+        error: "no account exists for this email yet",
+        code: "no_account_for_email",
+      }),
     );
     renderTab();
     const verifyBtn = await screen.findByRole("button", { name: /^Verify$/i });
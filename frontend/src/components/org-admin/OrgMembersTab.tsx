@@ -180,14 +180,14 @@ export function OrgMembersTab() {
                                 refresh();
                               } catch (e) {
                                 setNotice("");
-                                // 422 with body {"error":"no_account_for_email"}
+                                // 422 with body {"code":"no_account_for_email"}
                                 // means the invitee has no users row yet —
                                 // render a clear actionable message instead
                                 // of the raw "no_account_for_email" string.
                                 if (
                                   e instanceof ApiClientError &&
                                   e.status === 422 &&
-                                  e.body.error === "no_account_for_email"
+                                  e.body.code === "no_account_for_email"
                                 ) {
                                   setError(
                                     "No account exists for this email yet. The invitee must sign up before you can verify them.",
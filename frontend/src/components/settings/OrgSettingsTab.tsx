@@ -84,7 +84,7 @@ function CreateOrgForm({
         const details = e.body.details;
         const field = Object.keys(details)[0];
         if (field) {
-          const msg = details[field];
+          const msg = String(details[field]);
           const labels: Record<string, string> = {
             slug: "Slug",
             name: "Name",
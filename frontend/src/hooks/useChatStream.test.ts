@@ -183,7 +183,11 @@ describe("useChatStream", () => {
       // the proxy returns 503+retryAfter during the ~10s window. The send
       // must retry rather than drop the user's message. Real timers with a
       // 1s retryAfter keeps the test fast.
-      const err503 = new ApiClientError(503, { error: "workspace_restarting", retryAfter: 1 });
+      const err503 = new ApiClientError(503, {
+        error: "workspace_restarting",
+        code: "service_unavailable",
+        details: { retryAfter: 1 },
+      });
       (messagesApi.sendAsync as ReturnType<typeof vi.fn>)
         .mockRejectedValueOnce(err503)
         .mockResolvedValueOnce(undefined);
@@ -209,7 +213,11 @@ describe("useChatStream", () => {
     it("gives up after SEND_MAX_503_RETRIES and surfaces the 503 as an error", async () => {
       // After the bounded retry count the message is dropped with a visible
       // error so the loop cannot spin forever on a wedged workspace.
-      const err503 = new ApiClientError(503, { error: "workspace_restarting", retryAfter: 1 });
+      const err503 = new ApiClientError(503, {
+        error: "workspace_restarting",
+        code: "service_unavailable",
+        details: { retryAfter: 1 },
+      });
       (messagesApi.sendAsync as ReturnType<typeof vi.fn>).mockRejectedValue(err503);
       (messagesApi.getHistory as ReturnType<typeof vi.fn>).mockResolvedValue([]);
 
@@ -230,7 +238,12 @@ describe("useChatStream", () => {
     it("does not retry on 429 (handled by the separate at-cap path)", async () => {
       // Sanity: only 503 is a transient restart signal. 429 must NOT enter
       // the 503 retry loop — it surfaces via atCapRetryAfter instead.
-      const err429 = new ApiClientError(429, { error: "rate limited", retryAfter: 5 });
+      const err429 = new ApiClientError(429, {
+        error: "rate limited",
+        code: "rate_limited",
+        message: "rate limited",
+        details: { retryAfter: 5 },
+      });
       (messagesApi.sendAsync as ReturnType<typeof vi.fn>).mockRejectedValue(err429);
 
       const { result } = renderHook(() => useChatStream("sb-1", "sess-1"));
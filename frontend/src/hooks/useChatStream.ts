@@ -77,7 +77,7 @@ export function useChatStream(workspaceId: string | undefined, sessionId: string
           } catch (err: unknown) {
             const is503 = err instanceof ApiClientError && err.status === 503;
             if (!is503 || attempt === SEND_MAX_503_RETRIES) throw err;
-            const retryAfter = Number(err.body.retryAfter ?? 10);
+            const retryAfter = Number(err.body.details?.retryAfter ?? 10);
             await sleep(Math.min(isNaN(retryAfter) ? 10 : retryAfter, 30) * 1000);
           }
         }
@@ -130,7 +130,7 @@ export function useChatStream(workspaceId: string | undefined, sessionId: string
         onComplete(msg);
       } catch (err: unknown) {
         if (err instanceof ApiClientError && err.status === 429) {
-          const retryAfter = Number(err.body.retryAfter ?? 60);
+          const retryAfter = Number(err.body.details?.retryAfter ?? 60);
           setAtCapRetryAfter(isNaN(retryAfter) ? 60 : retryAfter);
         } else {
           const message = err instanceof Error ? err.message : "Failed to send message";
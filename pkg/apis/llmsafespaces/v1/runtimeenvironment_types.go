@@ -4,6 +4,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -12,6 +13,12 @@ type RuntimeEnvironmentSpec struct {
 	// Image is the container image for this runtime.
 	Image string `json:"image"`
 
+	// ImagePullPolicy applied to every container built from Image. Empty
+	// keeps the Kubernetes default: Always for :latest or untagged images,
+	// IfNotPresent otherwise.
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
 	// Language is the programming language (e.g., python, nodejs).
 	Language string `json:"language"`
 
@@ -31,7 +38,8 @@ type RuntimeEnvironmentSpec struct {
 	SecurityFeatures []string `json:"securityFeatures,omitempty"`
 
 	// ResourceRequirements describes the recommended resource requirements
-	// for this runtime.
+	// for this runtime. RecommendedCPU/RecommendedMemory become a new
+	// workspace's resources when the create request names no size.
 	ResourceRequirements *RuntimeResourceRequirements `json:"resourceRequirements,omitempty"`
 
 	// RequiresCredentials indicates that this runtime needs LLM provider
@@ -32,6 +32,12 @@ const (
 	AnnotationLastActivityAt = "llmsafespaces.dev/last-activity-at"
 )
 
+// StuckTerminatingThreshold is how long a Workspace may carry a deletion
+// timestamp before it counts as stuck. The controller reports stuck
+// deletions; the API's admin force-clean refuses younger ones so it never
+// races the controller's own cleanup.
+const StuckTerminatingThreshold = 10 * time.Minute
+
 // WorkspaceStorageConfig defines PVC configuration for a Workspace.
 type WorkspaceStorageConfig struct {
 	// +kubebuilder:validation:Pattern=^[1-9][0-9]*(Gi|Mi)$
@@ -161,6 +167,38 @@ type WorkspaceSpec struct {
 	// path), but direct kubectl users can set it.
 	RuntimeClass *string `json:"runtimeClass,omitempty"`
 
+	// Priority selects the scheduling priority of the workspace pod. The
+	// controller maps it to a Kubernetes PriorityClassName via its
+	// --priority-classes flag; a priority with no mapping (or empty)
+	// leaves the pod at the cluster default. Changes apply the next time
+	// the pod is created (pod priority is immutable).
+	// +kubebuilder:validation:Enum=low;normal;high
+	Priority WorkspacePriority `json:"priority,omitempty"`
+
+	// Region is a placement hint. When set, the workspace pod is pinned to
+	// nodes labelled topology.kubernetes.io/region=<Region>. The API only
+	// accepts regions listed in the workspace.allowedRegions setting.
+	// Because the PVC binds where the first pod schedules, changing Region
+	// on an existing workspace leaves its volume behind and is not
+	// supported.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`
+	Region string `json:"region,omitempty"`
+
+	// TerminationGracePeriodSeconds overrides the pod's shutdown grace
+	// period. Nil uses the controller default (5s). The API caps requests
+	// at the workspace.maxTerminationGracePeriodSeconds setting. Applies
+	// the next time the pod is created.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// Logging configures the in-pod workspace agent's logging. Nil keeps
+	// the agent default (info). Applies the next time the pod is created.
+	// +optional
+	Logging *WorkspaceLoggingConfig `json:"logging,omitempty"`
+
 	// AutoApprovePermissions controls whether permission requests from the agent
 	// are automatically approved without user interaction. When true, the backend
 	// replies "always" to all permission.asked events. Default: false.
@@ -194,6 +232,52 @@ type WorkspaceSpec struct {
 	Suspend *bool `json:"suspend,omitempty"`
 }
 
+// WorkspaceLoggingConfig is the per-workspace logging configuration.
+type WorkspaceLoggingConfig struct {
+	// Level is the workspace agent's log level. Empty means info.
+	// +kubebuilder:validation:Enum=debug;info;warn;error
+	Level AgentLogLevel `json:"level,omitempty"`
+}
+
+// AgentLogLevel is a workspace agent log level.
+type AgentLogLevel string
+
+const (
+	AgentLogLevelDebug AgentLogLevel = "debug"
+	AgentLogLevelInfo  AgentLogLevel = "info"
+	AgentLogLevelWarn  AgentLogLevel = "warn"
+	AgentLogLevelError AgentLogLevel = "error"
+)
+
+// IsValid reports whether l is one of the defined levels. The empty level
+// is valid and means "agent default".
+func (l AgentLogLevel) IsValid() bool {
+	switch l {
+	case "", AgentLogLevelDebug, AgentLogLevelInfo, AgentLogLevelWarn, AgentLogLevelError:
+		return true
+	}
+	return false
+}
+
+// WorkspacePriority is the scheduling priority requested for a workspace.
+type WorkspacePriority string
+
+const (
+	WorkspacePriorityLow    WorkspacePriority = "low"
+	WorkspacePriorityNormal WorkspacePriority = "normal"
+	WorkspacePriorityHigh   WorkspacePriority = "high"
+)
+
+// IsValid reports whether p is one of the defined priorities. The empty
+// priority is valid and means "cluster default".
+func (p WorkspacePriority) IsValid() bool {
+	switch p {
+	case "", WorkspacePriorityLow, WorkspacePriorityNormal, WorkspacePriorityHigh:
+		return true
+	}
+	return false
+}
+
 // WorkspacePhase represents the lifecycle phase of a Workspace.
 type WorkspacePhase string
 
@@ -209,8 +293,9 @@ const (
 	WorkspacePhaseFailed      WorkspacePhase = "Failed"
 )
 
-// FailureReason is a typed enum identifying why a workspace entered Failed.
-// Operators and frontend can switch on this for per-cause UX and alerting.
+// FailureReason is a typed enum identifying why a workspace failed to start
+// or is recovering from a pod failure. Operators and frontend can switch on
+// this for per-cause UX and alerting.
 type FailureReason string
 
 const (
@@ -221,6 +306,14 @@ const (
 	FailureReasonPVCBindTimeout          FailureReason = "PVCBindTimeout"
 	FailureReasonPendingTimeout          FailureReason = "PendingTimeout"
 	FailureReasonTooManyFailures         FailureReason = "TooManyFailures"
+	// Pod-level causes derived from pod conditions and container states.
+	FailureReasonImagePullFailed       FailureReason = "ImagePullFailed"
+	FailureReasonContainerConfigError  FailureReason = "ContainerConfigError"
+	FailureReasonInsufficientResources FailureReason = "InsufficientResources"
+	FailureReasonUnschedulable         FailureReason = "Unschedulable"
+	FailureReasonOOMKilled             FailureReason = "OOMKilled"
+	FailureReasonEvicted               FailureReason = "Evicted"
+	FailureReasonContainerCrashed      FailureReason = "ContainerCrashed"
 )
 
 type PVCState string
@@ -299,10 +392,19 @@ type WorkspaceStatus struct {
 	ObservedGeneration int64                `json:"observedGeneration,omitempty"`
 
 	// FailureReason provides a typed enum for programmatic consumers when
-	// Phase == Failed. Operators and frontend can switch on this without
-	// parsing free-form Message strings. Empty when not in Failed phase.
+	// the workspace is failing to start or recovering from a pod failure;
+	// Message carries the human-readable detail. Operators and frontend can
+	// switch on this without parsing free-form Message strings. Cleared when
+	// the workspace becomes Active.
 	FailureReason FailureReason `json:"failureReason,omitempty"`
 
+	// CreationProgress is a coarse percentage (0, 25, 50, 75, 100) for
+	// create/resume spinners, derived from the pod's lifecycle while the
+	// workspace is Creating: 25 scheduled, 50 init containers started
+	// (images pulled), 75 initialized, 100 all containers ready. Reset to
+	// 0 whenever a new pod is created.
+	CreationProgress int32 `json:"creationProgress,omitempty"`
+
 	// Pod status fields (absorbed from Sandbox) — controller-owned:
 	PodName                   string       `json:"podName,omitempty"`
 	PodNamespace              string       `json:"podNamespace,omitempty"`
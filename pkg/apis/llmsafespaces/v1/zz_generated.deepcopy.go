@@ -374,6 +374,16 @@ func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(WorkspaceLoggingConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSpec.
@@ -437,6 +447,21 @@ func (in *WorkspaceStatus) DeepCopy() *WorkspaceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceLoggingConfig) DeepCopyInto(out *WorkspaceLoggingConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceLoggingConfig.
+func (in *WorkspaceLoggingConfig) DeepCopy() *WorkspaceLoggingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceLoggingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceStorageConfig) DeepCopyInto(out *WorkspaceStorageConfig) {
 	*out = *in
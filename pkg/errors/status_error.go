@@ -61,6 +61,13 @@ func (e *StatusError) StatusCode() int {
 	return e.Status
 }
 
+// ErrorCode returns the machine-readable code. The API server's error
+// handler reports it in the response `code` field, falling back to a
+// status-derived code when empty.
+func (e *StatusError) ErrorCode() string {
+	return e.Code
+}
+
 // Unwrap returns the wrapped cause, enabling errors.Is and errors.As to
 // traverse the chain.
 func (e *StatusError) Unwrap() error {
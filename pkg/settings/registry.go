@@ -46,6 +46,10 @@ var (
 	KeyWorkspaceDefaultNetworkEgress     = register(Key{"workspace.defaultNetworkAccess.egressDomains", "workspace", []string{}})
 	KeyWorkspaceDefaultMaxActiveSessions = register(Key{"workspace.defaultMaxActiveSessions", "workspace", 0})
 	KeyWorkspaceMaxActivePerUser         = register(Key{"workspace.maxActiveWorkspacesPerUser", "workspace", 0})
+	KeyWorkspaceAllowedPriorities        = register(Key{"workspace.allowedPriorities", "workspace", []string{"low", "normal"}})
+	KeyWorkspaceAllowedRegions           = register(Key{"workspace.allowedRegions", "workspace", []string{}})
+	KeyWorkspaceMaxTerminationGrace      = register(Key{"workspace.maxTerminationGracePeriodSeconds", "workspace", 300})
+	KeyWorkspaceSizeProfiles             = register(Key{"workspace.sizeProfiles", "workspace", []string{"small=250m/512Mi", "medium=500m/1Gi", "large=2000m/4Gi"}})
 )
 
 // Auth settings
@@ -83,6 +83,11 @@ func InstanceSettings() []SettingDef {
 		{Key: "workspace.defaultResources.cpu", Tier: 2, Type: TypeString, Default: "500m", Pattern: CPUQuantityPattern, Category: "Workspace", Label: "Default CPU", Description: "Default CPU limit (e.g. 500m, 1.0)"},
 		{Key: "workspace.defaultResources.memory", Tier: 2, Type: TypeString, Default: "1Gi", Pattern: MemoryQuantityPattern, Category: "Workspace", Label: "Default Memory", Description: "Default memory limit (e.g. 512Mi, 1Gi). Suffix is case-sensitive; must be > 0."},
 
+		{Key: "workspace.allowedPriorities", Tier: 2, Type: TypeStrings, Default: []string{"low", "normal"}, Category: "Workspace", Label: "Allowed Priorities", Description: "Scheduling priorities (low, normal, high) non-admin users may request; admins may request any"},
+		{Key: "workspace.maxTerminationGracePeriodSeconds", Tier: 2, Type: TypeInt, Default: 300, Min: intPtr(0), Max: intPtr(3600), Category: "Workspace", Label: "Max Shutdown Grace (s)", Description: "Largest pod termination grace period users may request"},
+		{Key: "workspace.allowedRegions", Tier: 2, Type: TypeStrings, Default: []string{}, Category: "Workspace", Label: "Allowed Regions", Description: "Regions (node topology.kubernetes.io/region values) users may pin a workspace to; empty disables placement hints"},
+		{Key: "workspace.sizeProfiles", Tier: 2, Type: TypeStrings, Default: []string{"small=250m/512Mi", "medium=500m/1Gi", "large=2000m/4Gi"}, Category: "Workspace", Label: "Size Profiles", Description: "Named CPU/memory profiles users may request by size, as name=cpu/memory (e.g. small=250m/512Mi); cpu and memory use the same forms as Default CPU and Default Memory"},
+
 		// Auto-Suspend
 		{Key: "workspace.autoSuspend.enabled", Tier: 2, Type: TypeBool, Default: true, Category: "Auto-Suspend", Label: "Auto-Suspend", Description: "Global auto-suspend"},
 		{Key: "workspace.autoSuspend.idleTimeoutMinutes", Tier: 2, Type: TypeInt, Default: 60, Min: intPtr(5), Max: intPtr(10080), Category: "Auto-Suspend", Label: "Idle Timeout (min)", Description: "Idle timeout before auto-suspend"},
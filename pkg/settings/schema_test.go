@@ -4,6 +4,8 @@
 package settings
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -499,3 +501,26 @@ func TestValidate_StorageSize_RejectsBogusValues(t *testing.T) {
 		}
 	}
 }
+
+// TestSizeProfiles_DefaultsUseCanonicalQuantities guards the size
+// profiles against the same drift: a profile the webhook rejects (e.g.
+// a bare "2" CPU) fails every create that asks for that size.
+func TestSizeProfiles_DefaultsUseCanonicalQuantities(t *testing.T) {
+	cpu := regexp.MustCompile(CPUQuantityPattern)
+	mem := regexp.MustCompile(MemoryQuantityPattern)
+	registryDefault, _ := KeyWorkspaceSizeProfiles.Default().([]string)
+	schemaDefault, _ := InstanceSettingIndex()[KeyWorkspaceSizeProfiles.Name()].Default.([]string)
+	if len(registryDefault) == 0 || strings.Join(registryDefault, ",") != strings.Join(schemaDefault, ",") {
+		t.Fatalf("registry default %v and schema default %v must match", registryDefault, schemaDefault)
+	}
+	for _, entry := range registryDefault {
+		_, spec, _ := strings.Cut(entry, "=")
+		c, m, _ := strings.Cut(spec, "/")
+		if !cpu.MatchString(c) {
+			t.Errorf("size profile %q: cpu %q does not match CPUQuantityPattern", entry, c)
+		}
+		if !mem.MatchString(m) {
+			t.Errorf("size profile %q: memory %q does not match MemoryQuantityPattern", entry, m)
+		}
+	}
+}
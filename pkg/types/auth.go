@@ -51,6 +51,45 @@ type AuthResponse struct {
 	TokenTTL    time.Duration `json:"-"` // router-internal: not serialized
 }
 
+// ImportUsersRequest is the body of POST /api/v1/admin/users/import.
+type ImportUsersRequest struct {
+	Users []ImportUserRecord `json:"users"`
+}
+
+// ImportUserRecord is one user in a bulk import. Role defaults to "user".
+type ImportUserRecord struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role,omitempty"`
+}
+
+// Import outcomes reported per record.
+const (
+	ImportUserCreated = "created"
+	ImportUserFailed  = "failed"
+)
+
+// ImportUserResult is the outcome for one record, in request order.
+// RecoveryKey is returned once, like AuthResponse.RecoveryKey, so the
+// admin can hand it to the user; it is not stored anywhere recoverable.
+type ImportUserResult struct {
+	Index       int    `json:"index"`
+	Email       string `json:"email"`
+	Status      string `json:"status"`
+	UserID      string `json:"userId,omitempty"`
+	Role        string `json:"role,omitempty"`
+	RecoveryKey string `json:"recoveryKey,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ImportUsersResult summarises a bulk import.
+type ImportUsersResult struct {
+	Created int                `json:"created"`
+	Failed  int                `json:"failed"`
+	Results []ImportUserResult `json:"results"`
+}
+
 // CreateAPIKeyRequest is the request body for creating an API key.
 type CreateAPIKeyRequest struct {
 	Name          string   `json:"name" binding:"required,min=1,max=128"`
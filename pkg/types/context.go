@@ -28,6 +28,11 @@ const ContextKeyUserRole contextKey = "userRole"
 // (which would invert the dependency direction).
 const ContextKeyWorkspaceMeta contextKey = "workspaceMeta"
 
+// ContextKeyFreshWorkspaceRead marks a request whose workspace reads must
+// bypass the workspace service's short-TTL cache, e.g. a phase check that
+// gates an action. Set it with WithFreshWorkspaceRead.
+const ContextKeyFreshWorkspaceRead contextKey = "freshWorkspaceRead"
+
 // WorkspaceMetaFromCtx returns the *WorkspaceMetadata stored in ctx by
 // WorkspaceAccessMiddleware, or (nil, false) when the middleware did not run
 // (e.g. the caller is a background job, a route outside idGroup, or a unit
@@ -47,3 +52,19 @@ func WorkspaceMetaFromCtx(ctx context.Context) (*WorkspaceMetadata, bool) {
 	}
 	return m, true
 }
+
+// WithFreshWorkspaceRead returns ctx marked so GetWorkspace reads the
+// database and the Workspace CR instead of its cache.
+func WithFreshWorkspaceRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ContextKeyFreshWorkspaceRead, true)
+}
+
+// FreshWorkspaceReadFromCtx reports whether ctx was marked by
+// WithFreshWorkspaceRead.
+func FreshWorkspaceReadFromCtx(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	fresh, _ := ctx.Value(ContextKeyFreshWorkspaceRead).(bool)
+	return fresh
+}
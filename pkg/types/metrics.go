@@ -0,0 +1,26 @@
+// Copyright (C) 2026 Michael Kao
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import "time"
+
+// MetricsSnapshot is a point-in-time JSON summary of the API server's key
+// Prometheus metrics, served at GET /metrics/snapshot. Counters are
+// process-lifetime totals summed across labels, as on /metrics.
+type MetricsSnapshot struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	RequestsTotal             float64 `json:"requestsTotal"`
+	ErrorsTotal               float64 `json:"errorsTotal"`
+	RequestDurationAvgSeconds float64 `json:"requestDurationAvgSeconds"`
+	ActiveConnections         float64 `json:"activeConnections"`
+
+	WorkspacesCreatedTotal    float64 `json:"workspacesCreatedTotal"`
+	WorkspacesTerminatedTotal float64 `json:"workspacesTerminatedTotal"`
+
+	InferenceRequestsTotal     float64 `json:"inferenceRequestsTotal"`
+	InferenceInputTokensTotal  float64 `json:"inferenceInputTokensTotal"`
+	InferenceOutputTokensTotal float64 `json:"inferenceOutputTokensTotal"`
+	InferenceCostDollarsTotal  float64 `json:"inferenceCostDollarsTotal"`
+}
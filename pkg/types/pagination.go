@@ -25,4 +25,36 @@ type PaginationMetadata struct {
 type ListOptions struct {
 	Limit  int `json:"limit"`
 	Offset int `json:"offset"`
+	// Phase keeps only workspaces in this lifecycle phase ("Active",
+	// "Suspended", ...). Empty keeps all.
+	Phase string `json:"phase,omitempty"`
+	// Runtime keeps only workspaces created with exactly this runtime.
+	Runtime string `json:"runtime,omitempty"`
+	// Sort is a WorkspaceSort* field; empty sorts by creation time.
+	Sort string `json:"sort,omitempty"`
+	// Order is SortOrderAsc or SortOrderDesc; empty is descending.
+	Order string `json:"order,omitempty"`
+}
+
+// Sort fields accepted by workspace lists.
+const (
+	WorkspaceSortCreatedAt = "createdAt"
+	WorkspaceSortRuntime   = "runtime"
+)
+
+// Sort orders accepted by list endpoints.
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// WorkspaceListFilter narrows and orders the database workspace list.
+type WorkspaceListFilter struct {
+	// IDs restricts the list to these workspaces; nil means no restriction
+	// and an empty, non-nil slice matches nothing.
+	IDs     []string
+	Runtime string
+	// SortBy is a WorkspaceSort* field; empty sorts by creation time.
+	SortBy    string
+	Ascending bool
 }
@@ -23,6 +23,10 @@ type Workspace struct {
 	UpdatedAt               time.Time         `json:"updatedAt"`
 	AgentNeedsRefresh       bool              `json:"agentNeedsRefresh"`
 	CredentialsPendingSince *time.Time        `json:"credentialsPendingSince,omitempty"`
+	// Ready is set only on create responses that asked for
+	// waitUntilReady: true when the workspace reached Active before the
+	// timeout, false otherwise (Phase then carries the current phase).
+	Ready *bool `json:"ready,omitempty"`
 }
 
 // CreateWorkspaceRequest is the request body for creating a workspace.
@@ -33,6 +37,29 @@ type CreateWorkspaceRequest struct {
 	StorageClass string            `json:"storageClass,omitempty"`
 	Labels       map[string]string `json:"labels,omitempty"`
 	OrgID        *string           `json:"orgId,omitempty"`
+	// Priority is the scheduling priority ("low", "normal", "high").
+	// Empty uses the cluster default. Non-admins are limited to the
+	// workspace.allowedPriorities instance setting.
+	Priority string `json:"priority,omitempty"`
+	// Region pins the workspace to nodes in that region. Must be one of
+	// the workspace.allowedRegions instance setting.
+	Region string `json:"region,omitempty"`
+	// TerminationGracePeriodSeconds overrides the pod shutdown grace
+	// period. Capped at the workspace.maxTerminationGracePeriodSeconds
+	// instance setting.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// Size selects a named resource profile ("small", "medium", "large"
+	// by default) from the workspace.sizeProfiles instance setting. Empty
+	// uses the runtime's RuntimeEnvironment recommendation, else
+	// workspace.defaultResources.
+	Size string `json:"size,omitempty"`
+	// LogLevel sets the workspace agent's log level ("debug", "info",
+	// "warn", "error"). Empty keeps the agent default.
+	LogLevel string `json:"logLevel,omitempty"`
+	// WaitUntilReady makes the create call block until the workspace is
+	// Active or ReadyTimeoutSeconds elapses (default 30, max 55).
+	WaitUntilReady      bool `json:"waitUntilReady,omitempty"`
+	ReadyTimeoutSeconds int  `json:"readyTimeoutSeconds,omitempty"`
 }
 
 // WorkspaceListResult bundles workspace list items with pagination.
@@ -41,6 +68,21 @@ type WorkspaceListResult struct {
 	Pagination *PaginationMetadata `json:"pagination,omitempty"`
 }
 
+// BulkDeleteWorkspacesResult summarizes DELETE /workspaces?labelSelector=.
+// Skipped lists matches the caller may not delete; Errors lists deletes
+// that failed. All three are always present (possibly empty).
+type BulkDeleteWorkspacesResult struct {
+	Deleted []string                   `json:"deleted"`
+	Skipped []string                   `json:"skipped"`
+	Errors  []BulkDeleteWorkspaceError `json:"errors"`
+}
+
+// BulkDeleteWorkspaceError is one failed delete in a bulk request.
+type BulkDeleteWorkspaceError struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
 // WorkspaceListItem is a lightweight workspace representation for list responses.
 type WorkspaceListItem struct {
 	ID                      string     `json:"id"`
@@ -72,6 +114,8 @@ type WorkspaceStatusResult struct {
 	ActiveSessions   int                        `json:"activeSessions"`
 	LastActivityAt   *time.Time                 `json:"lastActivityAt,omitempty"`
 	Message          string                     `json:"message,omitempty"`
+	FailureReason    string                     `json:"failureReason,omitempty"`
+	CreationProgress int                        `json:"creationProgress"`
 	Conditions       []WorkspaceConditionResult `json:"conditions,omitempty"`
 	CredentialState  CredentialStateResult      `json:"credentialState"`
 	AgentHealth      AgentHealthResult          `json:"agentHealth"`
@@ -85,6 +129,24 @@ type WorkspaceStatusResult struct {
 	ContextTotal     int64                      `json:"contextTotal"`
 }
 
+// WorkspaceUsageSample is one point of a workspace's recent resource
+// usage, as reported by its agent at Timestamp. CPU is the average rate
+// since the previous sample.
+type WorkspaceUsageSample struct {
+	Timestamp          time.Time `json:"timestamp"`
+	CPUMillicores      int64     `json:"cpuMillicores"`
+	CPULimitMillicores int64     `json:"cpuLimitMillicores,omitempty"`
+	MemoryUsedBytes    int64     `json:"memoryUsedBytes"`
+	MemoryTotalBytes   int64     `json:"memoryTotalBytes,omitempty"`
+}
+
+// WorkspaceUsageHistory is the response of GET /workspaces/:id/metrics:
+// recent usage samples, oldest first.
+type WorkspaceUsageHistory struct {
+	WorkspaceID string                 `json:"workspaceId"`
+	Samples     []WorkspaceUsageSample `json:"samples"`
+}
+
 // WorkspaceMetadata is the database record for a workspace.
 //
 // Phase and pvc_state used to live here as a denormalised cache of the
@@ -147,3 +209,12 @@ type ActivateWorkspaceResponse struct {
 type RefreshWorkspaceResult struct {
 	RestartGeneration int64 `json:"restartGeneration"`
 }
+
+// ForceCleanWorkspaceResult is returned by the admin force-clean endpoint.
+// TerminatingSeconds is how long the deletion had been pending.
+type ForceCleanWorkspaceResult struct {
+	WorkspaceID        string   `json:"workspaceId"`
+	TerminatingSeconds int64    `json:"terminatingSeconds"`
+	PodDeleted         bool     `json:"podDeleted"`
+	RemovedFinalizers  []string `json:"removedFinalizers"`
+}
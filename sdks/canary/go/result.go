@@ -195,6 +195,19 @@ func HasField(body []byte, field string) bool {
 	return ok
 }
 
+// HasDetail returns true if an API error body carries the named key in its
+// details object.
+func HasDetail(body []byte, field string) bool {
+	var obj struct {
+		Details map[string]any `json:"details"`
+	}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return false
+	}
+	_, ok := obj.Details[field]
+	return ok
+}
+
 // FieldString returns a string field from JSON or "".
 func FieldString(body []byte, field string) string {
 	var obj map[string]any
@@ -102,7 +102,7 @@ func runPromptAsync(ctx context.Context, run *canary.Runner, cfg canary.Config)
 
 	// N2: prompt_async on non-Active workspace — verify 503 shape
 	// Create a workspace but don't wait for Active; immediately send a prompt.
-	// Should return 503 with {"error":"...","phase":"...","retryAfter":N} shape.
+	// Should return 503 with {"error":"...","details":{"phase":"...","retryAfter":N}} shape.
 	pendingWS, err2 := c.Workspaces.Create(ctx, llm.CreateWorkspaceRequest{
 		Name: "canary-pa-pending", Runtime: "base", StorageSize: "1Gi",
 	})
@@ -118,10 +118,10 @@ func runPromptAsync(ctx context.Context, run *canary.Runner, cfg canary.Config)
 		run.Assert(pendingStatus == 503 || pendingStatus == 400,
 			"prompt-async-not-ready: 503 or 400", fmt.Sprintf("got %d", pendingStatus))
 		if pendingStatus == 503 {
-			run.Assert(canary.HasField(pendingBody, "phase"),
-				"503-not-ready: phase field present", "")
-			run.Assert(canary.HasField(pendingBody, "retryAfter"),
-				"503-not-ready: retryAfter field present", "")
+			run.Assert(canary.HasDetail(pendingBody, "phase"),
+				"503-not-ready: phase detail present", "")
+			run.Assert(canary.HasDetail(pendingBody, "retryAfter"),
+				"503-not-ready: retryAfter detail present", "")
 		}
 	}
 }
@@ -116,14 +116,10 @@ func runSessionLimit(ctx context.Context, run *canary.Runner, cfg canary.Config)
 			run.Assert(status == 429, "p2-429-active-limit",
 				fmt.Sprintf("got %d", status))
 			if status == 429 {
-				var bodyObj map[string]any
-				_ = json.Unmarshal(body, &bodyObj)
-				_, hasMaxActive := bodyObj["maxActiveSessions"]
-				_, hasRetryAfter := bodyObj["retryAfter"]
-				run.Assert(hasMaxActive, "p2-has-maxActiveSessions",
-					fmt.Sprintf("keys: %v", bodyObj))
-				run.Assert(hasRetryAfter, "p2-has-retryAfter",
-					fmt.Sprintf("keys: %v", bodyObj))
+				run.Assert(canary.HasDetail(body, "maxActiveSessions"), "p2-has-maxActiveSessions",
+					string(body))
+				run.Assert(canary.HasDetail(body, "retryAfter"), "p2-has-retryAfter",
+					string(body))
 			}
 		}
 
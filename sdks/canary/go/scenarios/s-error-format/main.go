@@ -77,10 +77,10 @@ func runErrorFormat(ctx context.Context, run *canary.Runner, cfg canary.Config)
 			base+"/workspaces/"+ws.ID+"/sessions/canary-session-id/message",
 			cfg.APIKey, []byte(`{"content":"ping","parts":[{"type":"text","text":"ping"}]}`))
 		// Should get 503 (workspace not ready) or 400 (invalid session ID)
-		// The proxy returns 503 with phase+retryAfter when workspace isn't Active
+		// The proxy returns 503 with phase+retryAfter details when workspace isn't Active
 		if s7 == 503 {
-			run.Assert(canary.HasField(b7, "phase"), "503-not-ready: phase field", "")
-			run.Assert(canary.HasField(b7, "retryAfter"), "503-not-ready: retryAfter field", "")
+			run.Assert(canary.HasDetail(b7, "phase"), "503-not-ready: phase detail", "")
+			run.Assert(canary.HasDetail(b7, "retryAfter"), "503-not-ready: retryAfter detail", "")
 			run.Assert(canary.HasErrorField(b7), "503-not-ready: error field", "")
 		} else {
 			// 400 or other — still valid, just log
@@ -8,7 +8,6 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -90,17 +89,10 @@ func runWSQuota(ctx context.Context, run *canary.Runner, cfg canary.Config) {
 	if err != nil {
 		apiErr, ok := err.(*llm.APIError)
 		if ok {
-			var body map[string]any
-			if jsonErr := json.Unmarshal([]byte(apiErr.Message), &body); jsonErr == nil {
-				_, hasError := body["error"]
-				_, hasLimit := body["limit"]
-				run.Assert(hasError && hasLimit,
-					"N2: 429 body has error and limit fields",
-					fmt.Sprintf("error=%v limit=%v", hasError, hasLimit))
-			} else {
-				run.Fail("N2: 429 body has error and limit fields",
-					fmt.Sprintf("message is not JSON: %s", apiErr.Message))
-			}
+			_, hasLimit := apiErr.Details["limit"]
+			run.Assert(apiErr.Message != "" && hasLimit,
+				"N2: 429 body has error and limit detail",
+				fmt.Sprintf("message=%q details=%v", apiErr.Message, apiErr.Details))
 		}
 	}
 }
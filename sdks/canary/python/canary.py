@@ -212,6 +212,14 @@ def has_field(body: bytes, field: str) -> bool:
         return False
 
 
+def has_detail(body: bytes, field: str) -> bool:
+    try:
+        obj = json.loads(body)
+        return field in (obj.get("details") or {})
+    except Exception:
+        return False
+
+
 def contains_leaked_internals(body: bytes) -> bool:
     s = body.decode("utf-8", errors="replace").lower()
     for marker in ("panic:", "runtime error:", "goroutine ", "stack trace"):
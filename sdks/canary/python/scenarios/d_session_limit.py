@@ -105,8 +105,9 @@ def run(r: Runner, cfg: Config) -> None:
                 if status == 429:
                     body_obj = json.loads(body) if body else {}
                     r.assert_("error" in body_obj, "p2-has-error-field", "")
-                    r.assert_("retryAfter" in body_obj or "maxActiveSessions" in body_obj,
-                              "p2-has-limit-fields", f"keys={list(body_obj.keys())}")
+                    details = body_obj.get("details") or {}
+                    r.assert_("retryAfter" in details or "maxActiveSessions" in details,
+                              "p2-has-limit-fields", f"details={details}")
 
         for sid in session_ids[:max_active]:
             try:
@@ -18,6 +18,7 @@ from canary import (
     raw_do,
     has_error_field,
     has_field,
+    has_detail,
     contains_leaked_internals,
 )
 from llmsafespaces import LLMSafeSpaces
@@ -65,8 +66,8 @@ def run(run: Runner, cfg: Config) -> None:
             b'{"content":"ping","parts":[{"type":"text","text":"ping"}]}',
         )
         if s7 == 503:
-            run.assert_(has_field(b7, "phase"), "503-not-ready: phase field")
-            run.assert_(has_field(b7, "retryAfter"), "503-not-ready: retryAfter field")
+            run.assert_(has_detail(b7, "phase"), "503-not-ready: phase detail")
+            run.assert_(has_detail(b7, "retryAfter"), "503-not-ready: retryAfter detail")
             run.assert_(has_error_field(b7), "503-not-ready: error field")
         else:
             run.assert_(s7 >= 400, "proxy-error: 4xx/5xx", f"got {s7}")
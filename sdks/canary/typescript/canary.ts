@@ -210,6 +210,13 @@ export function hasField(body: Buffer | string, field: string): boolean {
   } catch { return false; }
 }
 
+export function hasDetail(body: Buffer | string, field: string): boolean {
+  try {
+    const obj = JSON.parse(body.toString());
+    return field in (obj.details ?? {});
+  } catch { return false; }
+}
+
 export function containsLeakedInternals(body: Buffer | string): boolean {
   const s = body.toString().toLowerCase();
   return ['panic:', 'runtime error:', 'goroutine ', 'stack trace'].some(m => s.includes(m));
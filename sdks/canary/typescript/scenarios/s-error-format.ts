@@ -3,7 +3,7 @@
 // S-ERROR-FORMAT canary — TypeScript SDK
 
 import { LLMSafeSpaces } from '../../src/index.js';
-import { Runner, Config, configFromEnv, nodeFetch, rawDo, hasErrorField, hasField, containsLeakedInternals } from '../canary.js';
+import { Runner, Config, configFromEnv, nodeFetch, rawDo, hasErrorField, hasField, hasDetail, containsLeakedInternals } from '../canary.js';
 
 async function run(run: Runner, cfg: Config): Promise<void> {
   const base = `${cfg.apiUrl}/api/v1`;
@@ -41,8 +41,8 @@ async function run(run: Runner, cfg: Config): Promise<void> {
       cfg.apiKey,
       Buffer.from('{"content":"ping","parts":[{"type":"text","text":"ping"}]}'));
     if (s7 === 503) {
-      run.assert(hasField(b7, 'phase'), '503-not-ready: phase field');
-      run.assert(hasField(b7, 'retryAfter'), '503-not-ready: retryAfter field');
+      run.assert(hasDetail(b7, 'phase'), '503-not-ready: phase detail');
+      run.assert(hasDetail(b7, 'retryAfter'), '503-not-ready: retryAfter detail');
       run.assert(hasErrorField(b7), '503-not-ready: error field');
     } else {
       run.assert(s7 >= 400, 'proxy-error: 4xx/5xx', `got ${s7}`);
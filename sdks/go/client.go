@@ -164,14 +164,25 @@ func (c *Client) login(ctx context.Context) error {
 	return nil
 }
 
+// errorBody is the API's error response: "error" is the legacy human
+// string, "code" the stable machine-readable one.
+type errorBody struct {
+	Error   string         `json:"error"`
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details"`
+}
+
 func parseError(resp *http.Response) error {
-	var errResp struct {
-		Error string `json:"error"`
+	var body errorBody
+	json.NewDecoder(resp.Body).Decode(&body)
+
+	msg := body.Message
+	if msg == "" {
+		msg = body.Error
 	}
-	json.NewDecoder(resp.Body).Decode(&errResp)
-	msg := errResp.Error
 	if msg == "" {
 		msg = resp.Status
 	}
-	return &APIError{Status: resp.StatusCode, Message: msg}
+	return &APIError{Status: resp.StatusCode, Code: body.Code, Message: msg, Details: body.Details}
 }
@@ -92,6 +92,34 @@ func TestClient_RateLimit(t *testing.T) {
 	}
 }
 
+// TestClient_ErrorCodes covers both error shapes the API sends: the flat
+// handler body with a legacy "error" string, and the middleware body
+// nested under "error".
+func TestClient_ErrorCodes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		w.Write([]byte(`{"error":"runtime \"ruby:3\" is not supported","code":"unsupported_runtime","message":"runtime \"ruby:3\" is not supported","details":{"runtime":"ruby:3"}}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, WithAPIKey("lsp_test"))
+	_, err := c.Workspaces.Create(context.Background(), CreateWorkspaceRequest{Name: "ws", Runtime: "ruby:3"})
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Status != 400 || apiErr.Code != "unsupported_runtime" {
+		t.Errorf("got status %d code %q", apiErr.Status, apiErr.Code)
+	}
+	if apiErr.Message != `runtime "ruby:3" is not supported` || apiErr.Details["runtime"] != "ruby:3" {
+		t.Errorf("got message %q details %v", apiErr.Message, apiErr.Details)
+	}
+	if !HasCode(err, "unsupported_runtime") || HasCode(err, "validation_error") {
+		t.Errorf("HasCode mismatch for %q", apiErr.Code)
+	}
+}
+
 func TestClient_SendMessage(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		json.NewEncoder(w).Encode(map[string]any{
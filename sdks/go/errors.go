@@ -6,15 +6,29 @@ package llmsafespaces
 import "fmt"
 
 // APIError represents an error response from the LLMSafeSpaces API.
+// Code is the server's machine-readable error code (e.g.
+// "validation_error", "unsupported_runtime"); it is empty only for
+// responses that did not carry one. Details holds the optional structured
+// context, such as the offending "field" of a validation error.
 type APIError struct {
 	Status  int
+	Code    string
 	Message string
+	Details map[string]any
 }
 
 func (e *APIError) Error() string {
 	return fmt.Sprintf("llmsafespaces: %d %s", e.Status, e.Message)
 }
 
+// HasCode returns true if the error carries the given API error code.
+func HasCode(err error, code string) bool {
+	if e, ok := err.(*APIError); ok {
+		return e.Code == code
+	}
+	return false
+}
+
 // IsNotFound returns true if the error is a 404.
 func IsNotFound(err error) bool {
 	if e, ok := err.(*APIError); ok {